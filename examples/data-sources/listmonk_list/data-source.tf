@@ -0,0 +1,3 @@
+data "listmonk_list" "newsletter" {
+  name = "newsletter"
+}
@@ -0,0 +1,8 @@
+data "listmonk_lists" "growth" {
+  tags = ["team:growth"]
+  type = "public"
+}
+
+locals {
+  list_ids = { for l in data.listmonk_lists.growth.lists : l.name => l.id }
+}
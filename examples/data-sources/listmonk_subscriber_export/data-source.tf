@@ -0,0 +1,12 @@
+data "listmonk_subscriber_export" "request" {
+  id = 42
+}
+
+resource "local_file" "export_bundle" {
+  filename = "${path.module}/exports/${data.listmonk_subscriber_export.request.profile.uuid}.json"
+  content  = data.listmonk_subscriber_export.request.json
+}
+
+output "subscribed_lists" {
+  value = data.listmonk_subscriber_export.request.subscriptions[*].name
+}
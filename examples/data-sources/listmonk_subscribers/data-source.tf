@@ -0,0 +1,11 @@
+data "listmonk_subscribers" "berlin" {
+  query               = "subscribers.attribs->>'city' = 'Berlin'"
+  list_ids            = [listmonk_list.newsletter.id]
+  subscription_status = "confirmed"
+  order_by            = "created_at"
+  max_results         = 5000
+}
+
+output "berlin_emails" {
+  value = data.listmonk_subscribers.berlin.subscribers[*].email
+}
@@ -0,0 +1,8 @@
+data "listmonk_templates" "campaign" {
+  type       = "campaign"
+  name_regex = "^newsletter-"
+}
+
+locals {
+  template_ids = { for t in data.listmonk_templates.campaign.templates : t.name => t.id }
+}
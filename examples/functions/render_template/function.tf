@@ -0,0 +1,18 @@
+resource "listmonk_template" "welcome" {
+  body    = file("${path.module}/welcome.html")
+  name    = "welcome"
+  subject = "Welcome {{ .Subscriber.FirstName }}"
+  type    = "tx"
+
+  lifecycle {
+    postcondition {
+      condition = strcontains(
+        provider::listmonk::render_template(self.body, {
+          subscriber = { name = "Jane Doe", attribs = { city = "Berlin" } }
+        }),
+        "Hello Jane"
+      )
+      error_message = "The welcome template must greet the subscriber by first name."
+    }
+  }
+}
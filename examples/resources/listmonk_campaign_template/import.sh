@@ -0,0 +1,2 @@
+# Campaign template can be imported using listmonk template id
+terraform import listmonk_campaign_template.example 1
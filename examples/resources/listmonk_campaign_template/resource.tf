@@ -0,0 +1,18 @@
+resource "listmonk_campaign_template" "newsletter" {
+  name = "newsletter"
+  body = <<-EOT
+    <html>
+      <body>
+        {{ template "content" . }}
+        <a href="{{ UnsubscribeURL }}">Unsubscribe</a>
+      </body>
+    </html>
+  EOT
+}
+
+# Existing listmonk_template resources of type campaign can be moved
+# without recreating the template in listmonk.
+moved {
+  from = listmonk_template.newsletter
+  to   = listmonk_campaign_template.newsletter
+}
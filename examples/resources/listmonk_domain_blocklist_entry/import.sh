@@ -0,0 +1,2 @@
+# Domain blocklist entries can be imported using the domain
+terraform import listmonk_domain_blocklist_entry.example mailinator.com
@@ -0,0 +1,7 @@
+resource "listmonk_domain_blocklist_entry" "disposable" {
+  domain = "mailinator.com"
+}
+
+resource "listmonk_domain_blocklist_entry" "competitor" {
+  domain = "*.competitor.example.com"
+}
@@ -0,0 +1,5 @@
+# List can be imported using listmonk list id
+terraform import listmonk_list.example 1
+
+# or using its name
+terraform import listmonk_list.example newsletter
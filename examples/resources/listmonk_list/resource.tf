@@ -0,0 +1,7 @@
+resource "listmonk_list" "newsletter" {
+  name        = "newsletter"
+  type        = "public"
+  optin       = "double"
+  tags        = ["product"]
+  description = "Monthly product newsletter"
+}
@@ -0,0 +1,2 @@
+# List subscription can be imported using listmonk subscriber id and list id
+terraform import listmonk_list_subscription.example 1:2
@@ -0,0 +1,5 @@
+resource "listmonk_list_subscription" "qa_newsletter" {
+  subscriber_id = listmonk_subscriber.qa.id
+  list_id       = listmonk_list.newsletter.id
+  status        = "confirmed"
+}
@@ -0,0 +1,5 @@
+# Subscriber can be imported using listmonk subscriber id
+terraform import listmonk_subscriber.example 1
+
+# or using its e-mail address
+terraform import listmonk_subscriber.example email:qa@example.com
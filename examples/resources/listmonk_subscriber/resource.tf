@@ -0,0 +1,30 @@
+resource "listmonk_subscriber" "qa" {
+  email = "qa@example.com"
+  name  = "QA inbox"
+  attribs = {
+    department = "engineering"
+    seed       = true
+  }
+  lists = [listmonk_list.newsletter.id]
+
+  preconfirm_subscriptions = true
+}
+
+# Only manage some attributes and leave the others, such as
+# last_login written by an application, untouched.
+resource "listmonk_subscriber" "staff" {
+  email = "jane@example.com"
+  attribs = {
+    department = "engineering"
+    locale     = "en"
+  }
+
+  managed_attribs_keys = ["department", "locale"]
+}
+
+# Keep the record blocklisted when it is removed from the configuration, so
+# that the address can't be added again.
+resource "listmonk_subscriber" "complaint" {
+  email      = "complaint@example.com"
+  on_destroy = "blocklist"
+}
@@ -0,0 +1,7 @@
+resource "listmonk_subscriber_blocklist" "complaints" {
+  subscriber_ids = [12, 42]
+}
+
+resource "listmonk_subscriber_blocklist" "competitor" {
+  query = "subscribers.email LIKE '%@competitor.example.com'"
+}
@@ -0,0 +1,12 @@
+resource "listmonk_subscriber_import" "newsletter" {
+  file     = "${path.module}/subscribers.csv"
+  list_ids = [listmonk_list.newsletter.id]
+}
+
+resource "listmonk_subscriber_import" "blocklist" {
+  content = <<-EOT
+    email,name
+    spam@example.com,Spammer
+  EOT
+  mode    = "blocklist"
+}
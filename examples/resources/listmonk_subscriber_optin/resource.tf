@@ -0,0 +1,15 @@
+resource "listmonk_subscriber" "onboarding" {
+  email = "new.hire@example.com"
+  lists = [listmonk_list.newsletter.id]
+
+  send_optin_email = true
+}
+
+# Send the opt-in e-mail again when the subscriber is added to more lists.
+resource "listmonk_subscriber_optin" "onboarding" {
+  subscriber_id = listmonk_subscriber.onboarding.id
+
+  triggers = {
+    lists = join(",", sort(listmonk_subscriber.onboarding.lists))
+  }
+}
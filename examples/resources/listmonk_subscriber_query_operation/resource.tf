@@ -0,0 +1,20 @@
+# Delete subscribers blocklisted for more than a year, once a day.
+resource "listmonk_subscriber_query_operation" "purge_blocklisted" {
+  operation             = "delete"
+  query                 = "subscribers.status = 'blocklisted' AND subscribers.updated_at < NOW() - INTERVAL '1 year'"
+  expected_max_affected = 500
+
+  triggers = {
+    day = formatdate("YYYY-MM-DD", plantimestamp())
+  }
+}
+
+# Move subscribers of a retired list to its successor.
+resource "listmonk_subscriber_query_operation" "migrate" {
+  operation             = "add"
+  query                 = "TRUE"
+  list_ids              = [listmonk_list.legacy.id]
+  target_list_ids       = [listmonk_list.newsletter.id]
+  subscription_status   = "confirmed"
+  expected_max_affected = 10000
+}
@@ -0,0 +1,22 @@
+locals {
+  staff = csvdecode(file("${path.module}/staff.csv"))
+}
+
+resource "listmonk_subscriber_set" "staff" {
+  list_id = listmonk_list.staff.id
+  subscribers = [
+    for person in local.staff : {
+      email   = person.email
+      name    = person.name
+      attribs = jsonencode({ department = person.department })
+    }
+  ]
+
+  preconfirm_subscriptions = true
+}
+
+# Large lists: only a hash of the file is kept in the state
+resource "listmonk_subscriber_set" "customers" {
+  list_id          = listmonk_list.customers.id
+  subscribers_file = "${path.module}/customers.csv"
+}
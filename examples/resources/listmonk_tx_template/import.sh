@@ -0,0 +1,2 @@
+# Transactional template can be imported using listmonk template id
+terraform import listmonk_tx_template.example 1
@@ -0,0 +1,12 @@
+resource "listmonk_tx_template" "password_reset" {
+  name    = "password-reset"
+  subject = "Reset your password, {{ .Subscriber.FirstName }}"
+  body    = "<p>Follow <a href=\"{{ .Tx.Data.reset_url }}\">this link</a> to reset your password.</p>"
+}
+
+# Existing listmonk_template resources of type tx can be moved
+# without recreating the template in listmonk.
+moved {
+  from = listmonk_template.password_reset
+  to   = listmonk_tx_template.password_reset
+}
@@ -4,3 +4,21 @@ resource "listmonk_template" "example" {
   subject = "Hello world"
   type    = "tx"
 }
+
+# Visual templates are exported from the listmonk v5 visual editor.
+resource "listmonk_template" "visual" {
+  body        = file("${path.module}/newsletter.html")
+  body_source = file("${path.module}/newsletter.json")
+  name        = "newsletter"
+  subject     = ""
+  type        = "campaign_visual"
+}
+
+# Very large templates can be read from a file, in which case only the
+# SHA-256 of the body is stored in the state.
+resource "listmonk_template" "large" {
+  body_file = "${path.module}/large-newsletter.html"
+  name      = "large-newsletter"
+  subject   = ""
+  type      = "campaign"
+}
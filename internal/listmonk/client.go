@@ -3,16 +3,71 @@ package listmonk
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
+// perPage is the page size used when fetching all pages of an endpoint.
+const perPage = 100
+
+// passwordMask is the character listmonk replaces passwords with when
+// returning the settings.
+const passwordMask = "\u2022"
+
+// settingsReloadDelay is how long listmonk is given to start reloading after
+// the settings are updated, and settingsReloadAttempts how many times its
+// health is then checked, once per delay, before giving up.
+const (
+	settingsReloadDelay    = time.Second
+	settingsReloadAttempts = 30
+)
+
+// ErrSettingsUnchanged is returned by UpdateSettings callbacks that leave the
+// settings as they are, so that they are not written back.
+var ErrSettingsUnchanged = errors.New("settings unchanged")
+
+// Error is returned when listmonk responds with a status other than 200 OK.
+type Error struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("error: %s\n%s", e.Status, e.Body)
+}
+
+// IsConflict reports whether err is a 409 Conflict response, e.g. for an
+// e-mail address that already exists.
+func IsConflict(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusConflict
+}
+
+// IsNotFound reports whether err is a 404 Not Found response.
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
 type Client struct {
 	Host     string
 	Username string
 	Password string
 	Headers  map[string]string
+
+	// settingsMu serialises read-modify-write updates of the settings, which
+	// listmonk only accepts as a whole.
+	settingsMu sync.Mutex
 }
 
 type Template struct {
@@ -24,6 +79,8 @@ type Template struct {
 	Type      string `json:"type"`
 	IsDefault bool   `json:"is_default,omitempty"`
 	Subject   string `json:"subject"`
+	// BodySource is the JSON source of visual templates (listmonk v5+).
+	BodySource *string `json:"body_source,omitempty"`
 }
 
 type TemplatesResponse struct {
@@ -34,6 +91,183 @@ type TemplateResponse struct {
 	Data Template `json:"data"`
 }
 
+type List struct {
+	ID              int      `json:"id,omitempty"`
+	UUID            string   `json:"uuid,omitempty"`
+	CreatedAt       string   `json:"created_at,omitempty"`
+	UpdatedAt       string   `json:"updated_at,omitempty"`
+	Name            string   `json:"name"`
+	Type            string   `json:"type"`
+	Optin           string   `json:"optin"`
+	Tags            []string `json:"tags"`
+	Description     string   `json:"description"`
+	SubscriberCount int      `json:"subscriber_count,omitempty"`
+	// SubscriberStatuses counts the list's subscriptions by status, e.g.
+	// unconfirmed, confirmed and unsubscribed.
+	SubscriberStatuses map[string]int `json:"subscriber_statuses,omitempty"`
+}
+
+type ListResponse struct {
+	Data List `json:"data"`
+}
+
+type Subscriber struct {
+	ID        int              `json:"id"`
+	UUID      string           `json:"uuid"`
+	CreatedAt string           `json:"created_at"`
+	UpdatedAt string           `json:"updated_at"`
+	Email     string           `json:"email"`
+	Name      string           `json:"name"`
+	Status    string           `json:"status"`
+	Attribs   json.RawMessage  `json:"attribs"`
+	Lists     []SubscriberList `json:"lists"`
+}
+
+// SubscriberList is a list a subscriber is subscribed to.
+type SubscriberList struct {
+	ID                 int    `json:"id"`
+	Name               string `json:"name"`
+	SubscriptionStatus string `json:"subscription_status"`
+}
+
+type SubscriberResponse struct {
+	Data Subscriber `json:"data"`
+}
+
+// SubscriberRequest is the body of subscriber create and update requests.
+// Lists replaces all of the subscriber's lists on update.
+type SubscriberRequest struct {
+	Email                   string          `json:"email"`
+	Name                    string          `json:"name"`
+	Status                  string          `json:"status"`
+	Lists                   []int           `json:"lists"`
+	Attribs                 json.RawMessage `json:"attribs,omitempty"`
+	PreconfirmSubscriptions bool            `json:"preconfirm_subscriptions"`
+}
+
+// SubscriberListsRequest is the body of bulk subscription requests. Action
+// is one of add, remove or unsubscribe, and Status is the status of added
+// subscriptions.
+type SubscriberListsRequest struct {
+	IDs           []int  `json:"ids"`
+	Action        string `json:"action"`
+	TargetListIDs []int  `json:"target_list_ids"`
+	Status        string `json:"status,omitempty"`
+}
+
+// SubscriberExport is a subscriber's data as exported for data-access
+// requests. listmonk leaves out the sections disabled in its privacy settings.
+type SubscriberExport struct {
+	Profile       []SubscriberExportProfile      `json:"profile"`
+	Subscriptions []SubscriberExportSubscription `json:"subscriptions"`
+	CampaignViews []SubscriberExportCampaignView `json:"campaign_views"`
+	LinkClicks    []SubscriberExportLinkClick    `json:"link_clicks"`
+}
+
+type SubscriberExportProfile struct {
+	UUID      string          `json:"uuid"`
+	Email     string          `json:"email"`
+	Name      string          `json:"name"`
+	Attribs   json.RawMessage `json:"attribs"`
+	Status    string          `json:"status"`
+	CreatedAt string          `json:"created_at"`
+	UpdatedAt string          `json:"updated_at"`
+}
+
+type SubscriberExportSubscription struct {
+	Name               string `json:"name"`
+	Type               string `json:"type"`
+	SubscriptionStatus string `json:"subscription_status"`
+	CreatedAt          string `json:"created_at"`
+}
+
+type SubscriberExportCampaignView struct {
+	Subject   string `json:"subject"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type SubscriberExportLinkClick struct {
+	URL       string `json:"url"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ImportParams are the parameters of a subscriber import. Mode is subscribe or
+// blocklist, and SubscriptionStatus the status of new list subscriptions.
+type ImportParams struct {
+	Mode               string `json:"mode"`
+	SubscriptionStatus string `json:"subscription_status,omitempty"`
+	Delimiter          string `json:"delim"`
+	Lists              []int  `json:"lists"`
+	Overwrite          bool   `json:"overwrite"`
+}
+
+// ImportStatus is the status of the current or latest subscriber import.
+// Status is one of none, importing, stopping, stopped, finished or failed.
+type ImportStatus struct {
+	Name     string `json:"name"`
+	Total    int    `json:"total"`
+	Imported int    `json:"imported"`
+	Status   string `json:"status"`
+}
+
+type ImportStatusResponse struct {
+	Data ImportStatus `json:"data"`
+}
+
+// SubscriberQueryRequest selects subscribers with a listmonk SQL expression,
+// optionally limited to some lists, for bulk operations. Action, TargetListIDs
+// and Status are only used when changing subscriptions, where Action is add,
+// remove or unsubscribe.
+type SubscriberQueryRequest struct {
+	Query         string `json:"query"`
+	ListIDs       []int  `json:"list_ids"`
+	Action        string `json:"action,omitempty"`
+	TargetListIDs []int  `json:"target_list_ids,omitempty"`
+	Status        string `json:"status,omitempty"`
+}
+
+// Settings are listmonk's settings, keyed by setting name, such as
+// privacy.domain_blocklist.
+type Settings map[string]interface{}
+
+type Campaign struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	Status            string `json:"status"`
+	TemplateID        int    `json:"template_id"`
+	ArchiveTemplateID int    `json:"archive_template_id"`
+}
+
+// Editable reports whether listmonk accepts changes to the campaign. Running,
+// finished and cancelled campaigns can't be edited.
+func (c Campaign) Editable() bool {
+	switch c.Status {
+	case "draft", "scheduled", "paused":
+		return true
+	}
+	return false
+}
+
+// PageResponse is the response of paginated listmonk endpoints.
+type PageResponse[T any] struct {
+	Data struct {
+		Results []T `json:"results"`
+		Total   int `json:"total"`
+		PerPage int `json:"per_page"`
+		Page    int `json:"page"`
+	} `json:"data"`
+}
+
+type ServerConfig struct {
+	Version string `json:"version"`
+}
+
+type ServerConfigResponse struct {
+	Data ServerConfig `json:"data"`
+}
+
 type DeleteResponse struct {
 	Data bool `json:"data"`
 }
@@ -48,13 +282,17 @@ func NewClient(host, username, password string, headers map[string]string) *Clie
 }
 
 func (c *Client) sendRequest(method, url string, body io.Reader) ([]byte, error) {
+	return c.sendRequestWithContentType(method, url, "application/json", body)
+}
+
+func (c *Client) sendRequestWithContentType(method, url, contentType string, body io.Reader) ([]byte, error) {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	req.SetBasicAuth(c.Username, c.Password)
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	for k, v := range c.Headers {
 		// remove qoutes from header values
 		req.Header.Add(k, v)
@@ -73,7 +311,11 @@ func (c *Client) sendRequest(method, url string, body io.Reader) ([]byte, error)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error: %s\n%s", resp.Status, string(responseBody))
+		return nil, &Error{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       string(responseBody),
+		}
 	}
 
 	return responseBody, nil
@@ -163,3 +405,620 @@ func (c *Client) DeleteTemplate(id int) error {
 
 	return nil
 }
+
+// GetServerConfig returns the public configuration of the listmonk server.
+func (c *Client) GetServerConfig() (*ServerConfig, error) {
+	url := c.Host + "/api/config"
+	responseBody, err := c.sendRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ServerConfigResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+var versionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)`)
+
+// VersionAtLeast reports whether the server version is major.minor or newer.
+// Unparseable versions, such as development builds, are assumed to be new enough.
+func (s *ServerConfig) VersionAtLeast(major, minor int) bool {
+	m := versionPattern.FindStringSubmatch(s.Version)
+	if m == nil {
+		return true
+	}
+
+	serverMajor, _ := strconv.Atoi(m[1])
+	serverMinor, _ := strconv.Atoi(m[2])
+	if serverMajor != major {
+		return serverMajor > major
+	}
+
+	return serverMinor >= minor
+}
+
+// SetDefaultTemplate makes the template with the given ID the default template.
+func (c *Client) SetDefaultTemplate(id int) error {
+	url := fmt.Sprintf("%s/api/templates/%d/default", c.Host, id)
+	_, err := c.sendRequest("PUT", url, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getAllPages fetches every page of a paginated endpoint and returns the
+// combined results.
+func getAllPages[T any](c *Client, path string, query url.Values) ([]T, error) {
+	results, _, err := getPages[T](c, path, query, 0)
+	return results, err
+}
+
+// getPages fetches pages of path until limit results have been fetched, or
+// all of them if limit is 0. It also returns the total number of results.
+func getPages[T any](c *Client, path string, query url.Values, limit int) ([]T, int, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	pageSize := perPage
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+	query.Set("per_page", strconv.Itoa(pageSize))
+
+	results := []T{}
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		responseBody, err := c.sendRequest("GET", c.Host+path+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var r PageResponse[T]
+		err = json.Unmarshal(responseBody, &r)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+		}
+
+		results = append(results, r.Data.Results...)
+		if limit > 0 && len(results) >= limit {
+			return results[:limit], r.Data.Total, nil
+		}
+		if len(r.Data.Results) == 0 || len(results) >= r.Data.Total {
+			return results, r.Data.Total, nil
+		}
+	}
+}
+
+// GetCampaigns returns all campaigns without their bodies.
+func (c *Client) GetCampaigns() ([]Campaign, error) {
+	return getAllPages[Campaign](c, "/api/campaigns", url.Values{"no_body": {"true"}})
+}
+
+// ReplaceCampaignTemplate replaces references to a template in a campaign's
+// template and archive template with another template, leaving all other
+// campaign fields untouched. It fails if the campaign isn't editable.
+func (c *Client) ReplaceCampaignTemplate(id, fromTemplateID, toTemplateID int) error {
+	url := fmt.Sprintf("%s/api/campaigns/%d", c.Host, id)
+	responseBody, err := c.sendRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	var r struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	campaign := r.Data
+	status, _ := campaign["status"].(string)
+	if !(Campaign{Status: status}).Editable() {
+		return fmt.Errorf("campaign %d can't be edited in status %s", id, status)
+	}
+
+	// The campaign is returned with expanded lists and media, but updates
+	// expect their IDs.
+	for _, key := range []string{"lists", "media"} {
+		items, _ := campaign[key].([]interface{})
+		ids := []interface{}{}
+		for _, item := range items {
+			if m, ok := item.(map[string]interface{}); ok {
+				ids = append(ids, m["id"])
+			}
+		}
+		campaign[key] = ids
+	}
+	for _, key := range []string{"template_id", "archive_template_id"} {
+		if templateID, ok := campaign[key].(float64); ok && int(templateID) == fromTemplateID {
+			campaign[key] = toTemplateID
+		}
+	}
+
+	campaignJSON, err := json.Marshal(campaign)
+	if err != nil {
+		return fmt.Errorf("error marshalling campaign: %w", err)
+	}
+
+	_, err = c.sendRequest("PUT", url, bytes.NewBuffer(campaignJSON))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetLists returns all lists matching the query parameters, e.g. query, tag
+// and type.
+func (c *Client) GetLists(query url.Values) ([]List, error) {
+	return getAllPages[List](c, "/api/lists", query)
+}
+
+// GetList returns a list by ID.
+func (c *Client) GetList(id int) (*List, error) {
+	url := fmt.Sprintf("%s/api/lists/%d", c.Host, id)
+	responseBody, err := c.sendRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+func (c *Client) CreateList(list *List) (*List, error) {
+	url := c.Host + "/api/lists"
+	listJSON, err := json.Marshal(list)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling list: %w", err)
+	}
+
+	responseBody, err := c.sendRequest("POST", url, bytes.NewBuffer(listJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+func (c *Client) UpdateList(list *List) (*List, error) {
+	url := fmt.Sprintf("%s/api/lists/%d", c.Host, list.ID)
+	listJSON, err := json.Marshal(list)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling list: %w", err)
+	}
+
+	responseBody, err := c.sendRequest("PUT", url, bytes.NewBuffer(listJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+func (c *Client) DeleteList(id int) error {
+	url := fmt.Sprintf("%s/api/lists/%d", c.Host, id)
+	_, err := c.sendRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSubscribers returns all subscribers matching the query parameters, e.g.
+// query and list_id.
+func (c *Client) GetSubscribers(query url.Values) ([]Subscriber, error) {
+	return getAllPages[Subscriber](c, "/api/subscribers", query)
+}
+
+// GetSubscribersLimit returns at most limit subscribers matching the query
+// parameters, and the total number of matching subscribers.
+func (c *Client) GetSubscribersLimit(query url.Values, limit int) ([]Subscriber, int, error) {
+	return getPages[Subscriber](c, "/api/subscribers", query, limit)
+}
+
+// GetSubscriberByEmail returns the subscriber with the given e-mail address,
+// ignoring case, or nil if there is none.
+func (c *Client) GetSubscriberByEmail(email string) (*Subscriber, error) {
+	query := fmt.Sprintf("LOWER(subscribers.email) = '%s'", strings.ReplaceAll(strings.ToLower(email), "'", "''"))
+	subscribers, err := c.GetSubscribers(url.Values{"query": {query}})
+	if err != nil {
+		return nil, err
+	}
+	if len(subscribers) == 0 {
+		return nil, nil
+	}
+
+	return &subscribers[0], nil
+}
+
+// GetSubscriber returns a subscriber by ID.
+func (c *Client) GetSubscriber(id int) (*Subscriber, error) {
+	url := fmt.Sprintf("%s/api/subscribers/%d", c.Host, id)
+	responseBody, err := c.sendRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r SubscriberResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+func (c *Client) CreateSubscriber(subscriber *SubscriberRequest) (*Subscriber, error) {
+	url := c.Host + "/api/subscribers"
+	subscriberJSON, err := json.Marshal(subscriber)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling subscriber: %w", err)
+	}
+
+	responseBody, err := c.sendRequest("POST", url, bytes.NewBuffer(subscriberJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	var r SubscriberResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+func (c *Client) UpdateSubscriber(id int, subscriber *SubscriberRequest) (*Subscriber, error) {
+	url := fmt.Sprintf("%s/api/subscribers/%d", c.Host, id)
+	subscriberJSON, err := json.Marshal(subscriber)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling subscriber: %w", err)
+	}
+
+	responseBody, err := c.sendRequest("PUT", url, bytes.NewBuffer(subscriberJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	var r SubscriberResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+func (c *Client) DeleteSubscriber(id int) error {
+	url := fmt.Sprintf("%s/api/subscribers/%d", c.Host, id)
+	_, err := c.sendRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSubscriberLists adds subscribers to, removes them from or
+// unsubscribes them from lists in bulk.
+func (c *Client) UpdateSubscriberLists(request *SubscriberListsRequest) error {
+	url := c.Host + "/api/subscribers/lists"
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("error marshalling subscriber lists: %w", err)
+	}
+
+	_, err = c.sendRequest("PUT", url, bytes.NewBuffer(requestJSON))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ExportSubscriber returns the data listmonk holds about a subscriber, both
+// decoded and as the raw JSON document listmonk serves for download.
+func (c *Client) ExportSubscriber(id int) (*SubscriberExport, []byte, error) {
+	url := fmt.Sprintf("%s/api/subscribers/%d/export", c.Host, id)
+	responseBody, err := c.sendRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var export SubscriberExport
+	err = json.Unmarshal(responseBody, &export)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &export, responseBody, nil
+}
+
+// SendSubscriberOptin sends a subscriber the opt-in e-mail asking to confirm
+// its unconfirmed subscriptions to double opt-in lists.
+func (c *Client) SendSubscriberOptin(id int) error {
+	url := fmt.Sprintf("%s/api/subscribers/%d/optin", c.Host, id)
+	_, err := c.sendRequest("POST", url, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ImportSubscribers starts importing subscribers from a CSV or ZIP file.
+// listmonk imports asynchronously; use GetImportStatus to follow the import.
+func (c *Client) ImportSubscribers(params *ImportParams, filename string, content []byte) (*ImportStatus, error) {
+	url := c.Host + "/api/import/subscribers"
+	paramsJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling import params: %w", err)
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	err = writer.WriteField("params", string(paramsJSON))
+	if err != nil {
+		return nil, fmt.Errorf("error writing import params: %w", err)
+	}
+	file, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, fmt.Errorf("error creating import file: %w", err)
+	}
+	_, err = file.Write(content)
+	if err != nil {
+		return nil, fmt.Errorf("error writing import file: %w", err)
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error writing import request: %w", err)
+	}
+
+	responseBody, err := c.sendRequestWithContentType("POST", url, writer.FormDataContentType(), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportStatusResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+// GetImportStatus returns the status of the current or latest import.
+func (c *Client) GetImportStatus() (*ImportStatus, error) {
+	url := c.Host + "/api/import/subscribers"
+	responseBody, err := c.sendRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImportStatusResponse
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return &r.Data, nil
+}
+
+// GetImportLogs returns the log of the current or latest import.
+func (c *Client) GetImportLogs() (string, error) {
+	url := c.Host + "/api/import/subscribers/logs"
+	responseBody, err := c.sendRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r struct {
+		Data string `json:"data"`
+	}
+	err = json.Unmarshal(responseBody, &r)
+	if err != nil {
+		return "", fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return r.Data, nil
+}
+
+// BlocklistSubscribers blocklists subscribers by ID and unsubscribes them
+// from all their lists.
+func (c *Client) BlocklistSubscribers(ids []int) error {
+	url := c.Host + "/api/subscribers/blocklist"
+	requestJSON, err := json.Marshal(map[string][]int{"ids": ids})
+	if err != nil {
+		return fmt.Errorf("error marshalling subscriber ids: %w", err)
+	}
+
+	_, err = c.sendRequest("PUT", url, bytes.NewBuffer(requestJSON))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BlocklistSubscribersByQuery blocklists the subscribers matching a query.
+func (c *Client) BlocklistSubscribersByQuery(request *SubscriberQueryRequest) error {
+	url := c.Host + "/api/subscribers/query/blocklist"
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("error marshalling blocklist query: %w", err)
+	}
+
+	_, err = c.sendRequest("PUT", url, bytes.NewBuffer(requestJSON))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSubscribersByQuery deletes the subscribers matching a query.
+func (c *Client) DeleteSubscribersByQuery(request *SubscriberQueryRequest) error {
+	url := c.Host + "/api/subscribers/query/delete"
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("error marshalling delete query: %w", err)
+	}
+
+	_, err = c.sendRequest("POST", url, bytes.NewBuffer(requestJSON))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSubscriberListsByQuery adds the subscribers matching a query to
+// lists, or removes or unsubscribes them from lists.
+func (c *Client) UpdateSubscriberListsByQuery(request *SubscriberQueryRequest) error {
+	url := c.Host + "/api/subscribers/query/lists"
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("error marshalling subscriber lists query: %w", err)
+	}
+
+	_, err = c.sendRequest("PUT", url, bytes.NewBuffer(requestJSON))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSettings returns listmonk's settings. Passwords are masked.
+func (c *Client) GetSettings() (Settings, error) {
+	url := c.Host + "/api/settings"
+	responseBody, err := c.sendRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Data Settings `json:"data"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(responseBody))
+	decoder.UseNumber()
+	err = decoder.Decode(&r)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling response body: %w\n%s", err, responseBody)
+	}
+
+	return r.Data, nil
+}
+
+// UpdateSettings reads the settings, applies update to them and writes them
+// back, then waits for listmonk to reload. Updates are serialised so that
+// concurrent updates of different settings don't overwrite each other. If
+// update returns ErrSettingsUnchanged, nothing is written.
+func (c *Client) UpdateSettings(update func(settings Settings) error) error {
+	c.settingsMu.Lock()
+	defer c.settingsMu.Unlock()
+
+	settings, err := c.GetSettings()
+	if err != nil {
+		return err
+	}
+	err = update(settings)
+	if errors.Is(err, ErrSettingsUnchanged) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// listmonk keeps the current password when an empty one is sent back.
+	clearPasswordMasks(settings)
+
+	url := c.Host + "/api/settings"
+	requestJSON, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("error marshalling settings: %w", err)
+	}
+
+	_, err = c.sendRequest("PUT", url, bytes.NewBuffer(requestJSON))
+	if err != nil {
+		return err
+	}
+
+	return c.waitForReload()
+}
+
+// waitForReload waits for listmonk to be healthy again after it reloads
+// following a settings update.
+func (c *Client) waitForReload() error {
+	url := c.Host + "/api/health"
+
+	var err error
+	for i := 0; i < settingsReloadAttempts; i++ {
+		time.Sleep(settingsReloadDelay)
+		_, err = c.sendRequest("GET", url, nil)
+		if err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("listmonk did not come back after reloading the settings: %w", err)
+}
+
+// clearPasswordMasks replaces the masked passwords returned by listmonk with
+// empty strings.
+func clearPasswordMasks(value interface{}) {
+	switch v := value.(type) {
+	case Settings:
+		clearPasswordMasks(map[string]interface{}(v))
+	case map[string]interface{}:
+		for key, item := range v {
+			if isPasswordMask(item) {
+				v[key] = ""
+			} else {
+				clearPasswordMasks(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if isPasswordMask(item) {
+				v[i] = ""
+			} else {
+				clearPasswordMasks(item)
+			}
+		}
+	}
+}
+
+func isPasswordMask(value interface{}) bool {
+	s, ok := value.(string)
+	return ok && s != "" && strings.Trim(s, passwordMask) == ""
+}
@@ -21,6 +21,11 @@ func TestAll(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("GetCampaigns", func(t *testing.T) {
+		_, err := client.GetCampaigns()
+		assert.NoError(t, err)
+	})
+
 	var templateID int
 	t.Run("CreateTemplate", func(t *testing.T) {
 		template := Template{
@@ -51,3 +56,59 @@ func TestAll(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := map[string]bool{
+		"v5.0.0":                  true,
+		"v5.1.2 (a1b2c3d)":        true,
+		"v6.0.0":                  true,
+		"v4.1.0":                  false,
+		"v4.9.9":                  false,
+		"4.0.0":                   false,
+		"development build abc12": true,
+	}
+
+	for version, expected := range tests {
+		config := ServerConfig{Version: version}
+		assert.Equal(t, expected, config.VersionAtLeast(5, 0), version)
+	}
+}
+
+func TestCampaignEditable(t *testing.T) {
+	tests := map[string]bool{
+		"draft":     true,
+		"scheduled": true,
+		"paused":    true,
+		"running":   false,
+		"finished":  false,
+		"cancelled": false,
+	}
+
+	for status, expected := range tests {
+		assert.Equal(t, expected, Campaign{Status: status}.Editable(), status)
+	}
+}
+
+func TestClearPasswordMasks(t *testing.T) {
+	settings := Settings{
+		"app.root_url": "http://localhost:9000",
+		"smtp": []interface{}{
+			map[string]interface{}{"host": "smtp.example.com", "password": "••••"},
+		},
+		"upload.s3.aws_secret_access_key": "••",
+		"privacy.domain_blocklist":        []interface{}{"example.com"},
+		"app.from_email":                  "",
+	}
+
+	clearPasswordMasks(settings)
+
+	assert.Equal(t, Settings{
+		"app.root_url": "http://localhost:9000",
+		"smtp": []interface{}{
+			map[string]interface{}{"host": "smtp.example.com", "password": ""},
+		},
+		"upload.s3.aws_secret_access_key": "",
+		"privacy.domain_blocklist":        []interface{}{"example.com"},
+		"app.from_email":                  "",
+	}, settings)
+}
@@ -0,0 +1,242 @@
+package listmonk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+)
+
+// RenderSubscriber is the subscriber exposed to templates as .Subscriber.
+type RenderSubscriber struct {
+	ID        int                    `json:"id"`
+	UUID      string                 `json:"uuid"`
+	Email     string                 `json:"email"`
+	Name      string                 `json:"name"`
+	Attribs   map[string]interface{} `json:"attribs"`
+	Status    string                 `json:"status"`
+	CreatedAt string                 `json:"created_at"`
+	UpdatedAt string                 `json:"updated_at"`
+}
+
+// FirstName returns the first word of the subscriber's name, like listmonk does.
+func (s RenderSubscriber) FirstName() string {
+	for _, chunk := range strings.Split(s.Name, " ") {
+		if chunk != "" {
+			return chunk
+		}
+	}
+
+	return s.Name
+}
+
+// LastName returns the last word of the subscriber's name, like listmonk does.
+func (s RenderSubscriber) LastName() string {
+	chunks := strings.Split(s.Name, " ")
+	for i := len(chunks) - 1; i >= 0; i-- {
+		chunk := chunks[i]
+		if chunk != "" {
+			return chunk
+		}
+	}
+
+	return s.Name
+}
+
+// RenderCampaign is the campaign exposed to templates as .Campaign.
+type RenderCampaign struct {
+	ID        int      `json:"id"`
+	UUID      string   `json:"uuid"`
+	Name      string   `json:"name"`
+	Subject   string   `json:"subject"`
+	FromEmail string   `json:"from_email"`
+	Body      string   `json:"body"`
+	AltBody   string   `json:"altbody"`
+	Tags      []string `json:"tags"`
+}
+
+// RenderTx is the transactional message exposed to templates as .Tx.
+type RenderTx struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+// RenderContext is the data templates are executed with. Its zero value is
+// not useful, use NewRenderContext to get the sample context.
+type RenderContext struct {
+	// RootURL is the root URL of the listmonk instance used to build links.
+	RootURL    string           `json:"root_url"`
+	Subscriber RenderSubscriber `json:"subscriber"`
+	Campaign   RenderCampaign   `json:"campaign"`
+	Tx         RenderTx         `json:"tx"`
+}
+
+// NewRenderContext returns a sample subscriber and campaign context, the
+// fields of which can be overridden with data shaped like the listmonk API
+// objects, e.g. {"subscriber": {"name": "Jane", "attribs": {"city": "Berlin"}}}.
+func NewRenderContext(data map[string]interface{}) (*RenderContext, error) {
+	ctx := &RenderContext{
+		RootURL: "https://listmonk.yoursite.com",
+		Subscriber: RenderSubscriber{
+			ID:      1,
+			UUID:    "00000000-0000-0000-0000-000000000001",
+			Email:   "subscriber@example.com",
+			Name:    "Sample Subscriber",
+			Attribs: map[string]interface{}{},
+			Status:  "enabled",
+		},
+		Campaign: RenderCampaign{
+			ID:        1,
+			UUID:      "00000000-0000-0000-0000-000000000002",
+			Name:      "Sample campaign",
+			Subject:   "Sample subject",
+			FromEmail: "listmonk <noreply@listmonk.yoursite.com>",
+			Body:      "<p>Sample content</p>",
+			Tags:      []string{},
+		},
+		Tx: RenderTx{
+			Data: map[string]interface{}{},
+		},
+	}
+	if data == nil {
+		return ctx, nil
+	}
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling data: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(dataJSON))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading data: %w", err)
+	}
+
+	return ctx, nil
+}
+
+// renderTemplateFuncs rewrite the shorthand forms of listmonk's template
+// functions to calls that take the message context, as listmonk does
+// before compiling a template.
+var renderTemplateFuncs = []struct {
+	regExp  *regexp.Regexp
+	replace string
+}{
+	{
+		regExp:  regexp.MustCompile("{{(\\s+)?TrackLink(\\s+)?\"(.+?)\"(\\s+)?}}"),
+		replace: `{{ TrackLink "$3" . }}`,
+	},
+	{
+		regExp:  regexp.MustCompile(`(https?://.+?)@TrackLink`),
+		replace: `{{ TrackLink "$1" . }}`,
+	},
+	{
+		regExp:  regexp.MustCompile(`{{(\s+)?(TrackView|UnsubscribeURL|ManageURL|OptinURL|MessageURL)(\s+)?}}`),
+		replace: `{{ $2 . }}`,
+	},
+}
+
+// renderI18n stands in for listmonk's i18n object. Without the server's
+// language packs, translation keys are returned as they are.
+type renderI18n struct{}
+
+// T returns the translation key.
+func (renderI18n) T(key string) string {
+	return key
+}
+
+// Ts returns the translation key.
+func (renderI18n) Ts(key string, _ ...string) string {
+	return key
+}
+
+// renderFuncMap returns listmonk's template function map: the generic sprig
+// functions plus listmonk's own functions. Links point at the context's root
+// URL, and TrackLink returns the link unchanged as there is no server to
+// register it with.
+func renderFuncMap(ctx *RenderContext) template.FuncMap {
+	root := strings.TrimRight(ctx.RootURL, "/")
+
+	funcs := template.FuncMap{
+		"TrackLink": func(url string, _ *RenderContext) string {
+			return url
+		},
+		"TrackView": func(c *RenderContext) template.HTML {
+			return template.HTML(fmt.Sprintf(`<img src="%s/campaign/%s/%s/px.png" alt="" />`, root, c.Campaign.UUID, c.Subscriber.UUID))
+		},
+		"UnsubscribeURL": func(c *RenderContext) string {
+			return fmt.Sprintf("%s/subscription/%s/%s", root, c.Campaign.UUID, c.Subscriber.UUID)
+		},
+		"ManageURL": func(c *RenderContext) string {
+			return fmt.Sprintf("%s/subscription/%s/%s?manage=true", root, c.Campaign.UUID, c.Subscriber.UUID)
+		},
+		"OptinURL": func(c *RenderContext) string {
+			return fmt.Sprintf("%s/subscription/optin/%s", root, c.Subscriber.UUID)
+		},
+		"MessageURL": func(c *RenderContext) string {
+			return fmt.Sprintf("%s/campaign/%s/%s", root, c.Campaign.UUID, c.Subscriber.UUID)
+		},
+		"ArchiveURL": func() string {
+			return root + "/archive"
+		},
+		"RootURL": func() string {
+			return root
+		},
+		"Date": func(layout string) string {
+			if layout == "" {
+				layout = time.ANSIC
+			}
+			return time.Now().Format(layout)
+		},
+		"L": func() renderI18n {
+			return renderI18n{}
+		},
+		"Safe": func(safeHTML string) template.HTML {
+			return template.HTML(safeHTML)
+		},
+	}
+	for k, v := range sprig.GenericFuncMap() {
+		if _, ok := funcs[k]; !ok {
+			funcs[k] = v
+		}
+	}
+
+	return funcs
+}
+
+// RenderTemplate renders a template body locally the way listmonk renders
+// campaign and transactional messages. The campaign body of the context is
+// available to the template as the "content" template.
+func RenderTemplate(body string, ctx *RenderContext) (string, error) {
+	for _, r := range renderTemplateFuncs {
+		body = r.regExp.ReplaceAllString(body, r.replace)
+	}
+	content := ctx.Campaign.Body
+	for _, r := range renderTemplateFuncs {
+		content = r.regExp.ReplaceAllString(content, r.replace)
+	}
+
+	tpl, err := template.New("base").Funcs(renderFuncMap(ctx)).Parse(body)
+	if err != nil {
+		return "", fmt.Errorf("error compiling template: %w", err)
+	}
+	if tpl.Lookup("content") == nil {
+		_, err = tpl.New("content").Parse(content)
+		if err != nil {
+			return "", fmt.Errorf("error compiling campaign body: %w", err)
+		}
+	}
+
+	var out bytes.Buffer
+	err = tpl.ExecuteTemplate(&out, "base", ctx)
+	if err != nil {
+		return "", fmt.Errorf("error rendering template: %w", err)
+	}
+
+	return out.String(), nil
+}
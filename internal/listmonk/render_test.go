@@ -0,0 +1,69 @@
+package listmonk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	ctx, err := NewRenderContext(map[string]interface{}{
+		"subscriber": map[string]interface{}{
+			"name":    "Jane Doe",
+			"attribs": map[string]interface{}{"city": "Berlin"},
+		},
+		"campaign": map[string]interface{}{
+			"body": `<p>Hi {{ .Subscriber.FirstName }}</p>`,
+		},
+	})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"subscriber": {
+			body:     `{{ .Subscriber.Name }} from {{ .Subscriber.Attribs.city }}`,
+			expected: "Jane Doe from Berlin",
+		},
+		"content": {
+			body:     `<div>{{ template "content" . }}</div>`,
+			expected: "<div><p>Hi Jane</p></div>",
+		},
+		"unsubscribe shorthand": {
+			body:     `{{ UnsubscribeURL }}`,
+			expected: "https://listmonk.yoursite.com/subscription/00000000-0000-0000-0000-000000000002/00000000-0000-0000-0000-000000000001",
+		},
+		"track link shorthand": {
+			body:     `<a href="https://example.com@TrackLink">x</a>`,
+			expected: `<a href="https://example.com">x</a>`,
+		},
+		"sprig": {
+			body:     `{{ "hello" | upper }}`,
+			expected: "HELLO",
+		},
+		"escaping": {
+			body:     `{{ "<b>" }}{{ Safe "<i>" }}`,
+			expected: "&lt;b&gt;<i>",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			out, err := RenderTemplate(tc.body, ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := NewRenderContext(map[string]interface{}{"subscriber": map[string]interface{}{"mail": "x"}})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		_, err := RenderTemplate(`{{ .Subscriber.Name `, ctx)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adoptExistingAttribute is the schema of the adopt_existing attribute shared
+// by resources of named objects.
+func adoptExistingAttribute(objectName string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Take over an existing %s with the same name on create instead of creating a duplicate. ", objectName) +
+			"The existing object is updated to the configured values. Defaults to the provider's `adopt_existing`",
+		Optional: true,
+	}
+}
+
+// shouldAdoptExisting returns the resource's adopt_existing value, falling
+// back to the provider-wide setting.
+func shouldAdoptExisting(value types.Bool, providerData *ListmonkProviderData) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+
+	return providerData.AdoptExisting
+}
+
+// findByName returns the object with the given name, or nil if there is none.
+// Names are not unique in listmonk, so several matches are an error.
+func findByName[T any](objects []T, name string, nameOf func(T) string) (*T, error) {
+	var found *T
+	for i := range objects {
+		if nameOf(objects[i]) != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("found more than one object named %q", name)
+		}
+		found = &objects[i]
+	}
+
+	return found, nil
+}
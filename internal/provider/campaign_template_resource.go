@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &campaignTemplateResource{}
+	_ resource.ResourceWithConfigure   = &campaignTemplateResource{}
+	_ resource.ResourceWithImportState = &campaignTemplateResource{}
+	_ resource.ResourceWithMoveState   = &campaignTemplateResource{}
+)
+
+// campaignTemplateType is the listmonk type of campaign templates.
+const campaignTemplateType = "campaign"
+
+// contentPlaceholder matches the placeholder campaign templates render the
+// campaign body at.
+var contentPlaceholder = regexp.MustCompile(`{{-?\s*template\s+"content"\s+\.\s*-?}}`)
+
+// NewCampaignTemplateResource is a helper function to simplify the provider implementation.
+func NewCampaignTemplateResource() resource.Resource {
+	return &campaignTemplateResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *campaignTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// campaignTemplateResource is the resource implementation.
+type campaignTemplateResource struct {
+	client       *listmonk.Client
+	providerData *ListmonkProviderData
+}
+
+// campaignTemplateResourceModel describes the resource data model.
+type campaignTemplateResourceModel struct {
+	ID        types.Int64       `tfsdk:"id"`
+	CreatedAt types.String      `tfsdk:"created_at"`
+	UpdatedAt types.String      `tfsdk:"updated_at"`
+	Name      types.String      `tfsdk:"name"`
+	Body      templateBodyValue `tfsdk:"body"`
+	IsDefault types.Bool        `tfsdk:"is_default"`
+	// ReassignToTemplateID is only used on deletion.
+	ReassignToTemplateID types.Int64 `tfsdk:"reassign_to_template_id"`
+	// AdoptExisting is only used on creation.
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
+// Metadata returns the resource type name.
+func (t *campaignTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_campaign_template"
+}
+
+// Schema defines the schema for the resource.
+func (t *campaignTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Campaign template. Existing `listmonk_template` resources of type `campaign` can be moved to this resource with a `moved` block (Terraform 1.8+)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Template identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Template created at",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Template updated at",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Template name",
+				Required:            true,
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "Template body. Must contain the `{{ template \"content\" . }}` placeholder the campaign body is rendered at. " +
+					"Differences in line endings and trailing whitespace are ignored",
+				Required:   true,
+				CustomType: templateBodyType{},
+				Validators: []validator.String{
+					contentPlaceholderValidator{},
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Template is default",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reassign_to_template_id": schema.Int64Attribute{
+				MarkdownDescription: "Template to move references to when this template is deleted. " +
+					"If this template is the default template or is used by campaigns, deletion fails unless this is set, " +
+					"in which case the other template is made the default and the campaigns are switched to it before deletion. " +
+					"Only draft, scheduled and paused campaigns can be switched; deletion fails without changes if other campaigns use the template. " +
+					"Must be applied before the template is destroyed",
+				Optional: true,
+			},
+			"adopt_existing": adoptExistingAttribute("template"),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (t *campaignTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan campaignTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	template := listmonk.Template{
+		Body: plan.Body.ValueString(),
+		Name: plan.Name.ValueString(),
+		Type: campaignTemplateType,
+	}
+
+	// Create the resource, or update an adopted one
+	r, diags := createOrAdoptTemplate(ctx, t.client, shouldAdoptExisting(plan.AdoptExisting, t.providerData), &template)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(r.ID))
+	plan.CreatedAt = types.StringValue(r.CreatedAt)
+	plan.UpdatedAt = types.StringValue(r.UpdatedAt)
+	plan.IsDefault = types.BoolValue(r.IsDefault)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (t *campaignTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state campaignTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "id", state.ID)
+	tflog.Info(ctx, "Reading campaign template")
+
+	// Get refreshed template value from Listmonk
+	template, err := t.client.GetTemplate(int(state.ID.ValueInt64()))
+	if listmonk.IsNotFound(err) {
+		tflog.Warn(ctx, "Campaign template not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read template",
+			fmt.Sprintf("Failed to read template: %s", err),
+		)
+
+		return
+	}
+	if template.Type != campaignTemplateType {
+		resp.Diagnostics.AddError(
+			"Unexpected template type",
+			fmt.Sprintf("Template %d has type %q, expected %q.", template.ID, template.Type, campaignTemplateType),
+		)
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.CreatedAt = types.StringValue(template.CreatedAt)
+	state.UpdatedAt = types.StringValue(template.UpdatedAt)
+	state.Name = types.StringValue(template.Name)
+	state.Body = newTemplateBodyValue(template.Body)
+	state.IsDefault = types.BoolValue(template.IsDefault)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (t *campaignTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan campaignTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	template := listmonk.Template{
+		ID:   int(plan.ID.ValueInt64()),
+		Body: plan.Body.ValueString(),
+		Name: plan.Name.ValueString(),
+		Type: campaignTemplateType,
+	}
+
+	// Update existing template
+	r, err := t.client.UpdateTemplate(&template)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update template",
+			fmt.Sprintf("Failed to update template: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.UpdatedAt = types.StringValue(r.UpdatedAt)
+	plan.IsDefault = types.BoolValue(r.IsDefault)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (t *campaignTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state campaignTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing template
+	resp.Diagnostics.Append(deleteTemplate(t.client, int(state.ID.ValueInt64()), state.ReassignToTemplateID)...)
+}
+
+func (r *campaignTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	templateId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse template ID (importing)",
+			fmt.Sprintf("Unable to parse template ID: %s", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), templateId)...)
+}
+
+// MoveState moves listmonk_template resources of type campaign to this resource.
+func (t *campaignTemplateResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		templateStateMover(ctx, campaignTemplateType, func(ctx context.Context, source templateResourceModel, resp *resource.MoveStateResponse) {
+			target := campaignTemplateResourceModel{
+				ID:                   source.ID,
+				CreatedAt:            source.CreatedAt,
+				UpdatedAt:            source.UpdatedAt,
+				Name:                 source.Name,
+				Body:                 source.Body,
+				IsDefault:            source.IsDefault,
+				ReassignToTemplateID: source.ReassignToTemplateID,
+				AdoptExisting:        source.AdoptExisting,
+			}
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, target)...)
+		}),
+	}
+}
+
+// contentPlaceholderValidator checks that a campaign template body contains
+// the placeholder the campaign body is rendered at.
+type contentPlaceholderValidator struct{}
+
+func (v contentPlaceholderValidator) Description(_ context.Context) string {
+	return `value must contain {{ template "content" . }}`
+}
+
+func (v contentPlaceholderValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v contentPlaceholderValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !contentPlaceholder.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Missing content placeholder",
+			`Campaign templates must contain {{ template "content" . }}, where listmonk renders the campaign body.`,
+		)
+	}
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccCampaignTemplateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_campaign_template" "test" {
+					body = "<p>Hello world</p>"
+					name = "tf-test-campaign"
+				}
+`,
+				ExpectError: regexp.MustCompile("Missing content placeholder"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_campaign_template" "test" {
+					body = "<div>{{ template \"content\" . }}</div>"
+					name = "tf-test-campaign"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_campaign_template.test", "name", "tf-test-campaign"),
+					resource.TestCheckResourceAttr("listmonk_campaign_template.test", "is_default", "false"),
+					resource.TestCheckResourceAttrSet("listmonk_campaign_template.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "listmonk_campaign_template.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_campaign_template" "test" {
+					body = "<section>{{ template \"content\" . }}</section>"
+					name = "tf-test-campaign"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_campaign_template.test", "body", "<section>{{ template \"content\" . }}</section>"),
+				),
+			},
+			// Read removes templates deleted outside of terraform
+			{
+				PreConfig: func() {
+					client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+					templates, err := client.GetTemplates()
+					require.NoError(t, err)
+					for _, template := range *templates {
+						if template.Name == "tf-test-campaign" {
+							require.NoError(t, client.DeleteTemplate(template.ID))
+						}
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if _, ok := s.RootModule().Resources["listmonk_campaign_template.test"]; ok {
+						return fmt.Errorf("expected deleted template to be removed from state")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccCampaignTemplateResourceMoveState(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "listmonk_template" "test" {
+					body    = "<div>{{ template \"content\" . }}</div>"
+					name    = "tf-test-campaign-move"
+					subject = ""
+					type    = "campaign"
+				}
+`,
+			},
+			{
+				Config: providerConfig + `
+				moved {
+					from = listmonk_template.test
+					to   = listmonk_campaign_template.test
+				}
+
+				resource "listmonk_campaign_template" "test" {
+					body = "<div>{{ template \"content\" . }}</div>"
+					name = "tf-test-campaign-move"
+				}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_campaign_template.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_campaign_template.test", "name", "tf-test-campaign-move"),
+				),
+			},
+		},
+	})
+}
+
+func TestCampaignTemplateResourceMoveState(t *testing.T) {
+	server, err := testAccProtoV6ProviderFactories["listmonk"]()
+	require.NoError(t, err)
+
+	moveState := func(templateType string) *tfprotov6.MoveResourceStateResponse {
+		resp, err := server.MoveResourceState(context.Background(), &tfprotov6.MoveResourceStateRequest{
+			SourceProviderAddress: "registry.terraform.io/muravlev/listmonk",
+			SourceTypeName:        "listmonk_template",
+			SourceSchemaVersion:   1,
+			SourceState: &tfprotov6.RawState{
+				JSON: []byte(`{
+					"id": 3,
+					"created_at": "2024-01-01T00:00:00Z",
+					"updated_at": "2024-01-01T00:00:00Z",
+					"name": "tf-test",
+					"body": "<div>{{ template \"content\" . }}</div>",
+					"type": "` + templateType + `",
+					"is_default": false,
+					"subject": "",
+					"body_source": null,
+					"reassign_to_template_id": null,
+					"adopt_existing": null
+				}`),
+			},
+			TargetTypeName: "listmonk_campaign_template",
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := moveState("campaign")
+	require.Empty(t, resp.Diagnostics)
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	moved, err := resp.TargetState.Unmarshal(schemaResp.ResourceSchemas["listmonk_campaign_template"].ValueType())
+	require.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	require.NoError(t, moved.As(&attributes))
+	var name string
+	require.NoError(t, attributes["name"].As(&name))
+	assert.Equal(t, "tf-test", name)
+
+	resp = moveState("tx")
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, "Unable to move template state", resp.Diagnostics[0].Summary)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsAllAttribute is the schema of the tags_all attribute of taggable
+// resources.
+func tagsAllAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "All tags of the object, including the provider's `default_tags`",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+}
+
+// mergeDefaultTags returns the union of the resource's tags and the
+// provider's default tags.
+func mergeDefaultTags(ctx context.Context, tags types.Set, defaultTags []string) (types.Set, diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return types.SetUnknown(types.StringType), nil
+	}
+
+	resourceTags := []string{}
+	diags := tags.ElementsAs(ctx, &resourceTags, false)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), diags
+	}
+
+	merged := map[string]bool{}
+	for _, tag := range resourceTags {
+		merged[tag] = true
+	}
+	for _, tag := range defaultTags {
+		merged[tag] = true
+	}
+
+	tagsAll, d := types.SetValueFrom(ctx, types.StringType, sortedTags(merged))
+	diags.Append(d...)
+
+	return tagsAll, diags
+}
+
+// withoutDefaultTags returns the remote tags of an object without the
+// provider's default tags, so that they do not show up as resource tags.
+// Default tags that were configured on the resource as well are kept.
+func withoutDefaultTags(ctx context.Context, remoteTags []string, priorTags types.Set, defaultTags []string) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configured := map[string]bool{}
+	if !priorTags.IsNull() && !priorTags.IsUnknown() {
+		prior := []string{}
+		diags.Append(priorTags.ElementsAs(ctx, &prior, false)...)
+		for _, tag := range prior {
+			configured[tag] = true
+		}
+	}
+	isDefault := map[string]bool{}
+	for _, tag := range defaultTags {
+		isDefault[tag] = true
+	}
+
+	tags := map[string]bool{}
+	for _, tag := range remoteTags {
+		if isDefault[tag] && !configured[tag] {
+			continue
+		}
+		tags[tag] = true
+	}
+
+	tagsValue, d := types.SetValueFrom(ctx, types.StringType, sortedTags(tags))
+	diags.Append(d...)
+
+	return tagsValue, diags
+}
+
+func sortedTags(tags map[string]bool) []string {
+	sorted := make([]string, 0, len(tags))
+	for tag := range tags {
+		sorted = append(sorted, tag)
+	}
+	sort.Strings(sorted)
+
+	return sorted
+}
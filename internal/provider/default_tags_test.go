@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	ctx := context.Background()
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, []string{"newsletter", "team:growth"})
+	require.False(t, diags.HasError())
+
+	tagsAll, diags := mergeDefaultTags(ctx, tags, []string{"managed-by:terraform", "team:growth"})
+	require.False(t, diags.HasError())
+	expected, _ := types.SetValueFrom(ctx, types.StringType, []string{"managed-by:terraform", "newsletter", "team:growth"})
+	assert.True(t, tagsAll.Equal(expected), "got %s", tagsAll)
+
+	tagsAll, diags = mergeDefaultTags(ctx, types.SetUnknown(types.StringType), []string{"managed-by:terraform"})
+	require.False(t, diags.HasError())
+	assert.True(t, tagsAll.IsUnknown())
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	ctx := context.Background()
+	defaultTags := []string{"managed-by:terraform", "team:growth"}
+	remoteTags := []string{"managed-by:terraform", "newsletter", "team:growth"}
+
+	t.Run("default tags are removed", func(t *testing.T) {
+		tags, diags := withoutDefaultTags(ctx, remoteTags, types.SetNull(types.StringType), defaultTags)
+		require.False(t, diags.HasError())
+		expected, _ := types.SetValueFrom(ctx, types.StringType, []string{"newsletter"})
+		assert.True(t, tags.Equal(expected), "got %s", tags)
+	})
+
+	t.Run("configured default tags are kept", func(t *testing.T) {
+		prior, _ := types.SetValueFrom(ctx, types.StringType, []string{"newsletter", "team:growth"})
+		tags, diags := withoutDefaultTags(ctx, remoteTags, prior, defaultTags)
+		require.False(t, diags.HasError())
+		assert.True(t, tags.Equal(prior), "got %s", tags)
+	})
+}
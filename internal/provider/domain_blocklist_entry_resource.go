@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &domainBlocklistEntryResource{}
+	_ resource.ResourceWithConfigure   = &domainBlocklistEntryResource{}
+	_ resource.ResourceWithImportState = &domainBlocklistEntryResource{}
+)
+
+// domainBlocklistSetting is the listmonk setting holding the blocklisted domains.
+const domainBlocklistSetting = "privacy.domain_blocklist"
+
+// domainPattern matches domains, optionally with a leading wildcard label.
+var domainPattern = regexp.MustCompile(`^(\*\.)?[^\s@*]+$`)
+
+// NewDomainBlocklistEntryResource is a helper function to simplify the provider implementation.
+func NewDomainBlocklistEntryResource() resource.Resource {
+	return &domainBlocklistEntryResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *domainBlocklistEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// domainBlocklistEntryResource is the resource implementation.
+type domainBlocklistEntryResource struct {
+	client *listmonk.Client
+}
+
+// domainBlocklistEntryResourceModel describes the resource data model.
+type domainBlocklistEntryResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Domain types.String `tfsdk:"domain"`
+}
+
+// Metadata returns the resource type name.
+func (d *domainBlocklistEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_blocklist_entry"
+}
+
+// Schema defines the schema for the resource.
+func (d *domainBlocklistEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A domain in listmonk's privacy domain blocklist. Subscribers with e-mail addresses in blocklisted domains " +
+			"can't subscribe. Other entries of the blocklist are left untouched. Updating the settings makes listmonk reload",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Entry identifier, the domain",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain to blocklist, for example `example.com` or `*.example.com`. Case is ignored",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(domainPattern, "must be a domain, optionally starting with `*.`"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (d *domainBlocklistEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan domainBlocklistEntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	domain := plan.Domain.ValueString()
+	ctx = tflog.SetField(ctx, "domain", domain)
+	tflog.Info(ctx, "Adding domain to blocklist")
+
+	err := d.client.UpdateSettings(func(settings listmonk.Settings) error {
+		domains, err := domainBlocklist(settings)
+		if err != nil {
+			return err
+		}
+		if indexOfDomain(domains, domain) >= 0 {
+			return listmonk.ErrSettingsUnchanged
+		}
+		settings[domainBlocklistSetting] = append(domains, domain)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to add domain to blocklist",
+			fmt.Sprintf("Failed to update the domain blocklist: %s", err),
+		)
+		return
+	}
+
+	// Populate Computed attribute values
+	plan.ID = plan.Domain
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *domainBlocklistEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state domainBlocklistEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "domain", state.Domain)
+	tflog.Info(ctx, "Reading domain blocklist entry")
+
+	settings, err := d.client.GetSettings()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read domain blocklist entry",
+			fmt.Sprintf("Failed to read settings: %s", err),
+		)
+		return
+	}
+	domains, err := domainBlocklist(settings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read domain blocklist entry",
+			err.Error(),
+		)
+		return
+	}
+
+	if indexOfDomain(domains, state.Domain.ValueString()) < 0 {
+		tflog.Warn(ctx, "Domain not found in blocklist, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.ID = state.Domain
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called: all attributes require replacement.
+func (d *domainBlocklistEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (d *domainBlocklistEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state domainBlocklistEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	domain := state.Domain.ValueString()
+	ctx = tflog.SetField(ctx, "domain", domain)
+	tflog.Info(ctx, "Removing domain from blocklist")
+
+	err := d.client.UpdateSettings(func(settings listmonk.Settings) error {
+		domains, err := domainBlocklist(settings)
+		if err != nil {
+			return err
+		}
+		if indexOfDomain(domains, domain) < 0 {
+			return listmonk.ErrSettingsUnchanged
+		}
+		remaining := []string{}
+		for _, entry := range domains {
+			if !sameDomain(entry, domain) {
+				remaining = append(remaining, entry)
+			}
+		}
+		settings[domainBlocklistSetting] = remaining
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to remove domain from blocklist",
+			fmt.Sprintf("Failed to update the domain blocklist: %s", err),
+		)
+		return
+	}
+}
+
+// ImportState imports the entry of a domain.
+func (d *domainBlocklistEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), req.ID)...)
+}
+
+// domainBlocklist returns the blocklisted domains in the settings.
+func domainBlocklist(settings listmonk.Settings) ([]string, error) {
+	value, ok := settings[domainBlocklistSetting]
+	if !ok || value == nil {
+		return []string{}, nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T of setting %s", value, domainBlocklistSetting)
+	}
+	domains := make([]string, 0, len(items))
+	for _, item := range items {
+		domain, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T in setting %s", item, domainBlocklistSetting)
+		}
+		domains = append(domains, domain)
+	}
+	return domains, nil
+}
+
+// indexOfDomain returns the index of domain in domains, ignoring case, or -1.
+func indexOfDomain(domains []string, domain string) int {
+	for i, entry := range domains {
+		if sameDomain(entry, domain) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameDomain reports whether a blocklist entry is the given domain.
+func sameDomain(entry, domain string) bool {
+	return strings.EqualFold(strings.TrimSpace(entry), domain)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainBlocklist(t *testing.T) {
+	domains, err := domainBlocklist(listmonk.Settings{
+		domainBlocklistSetting: []interface{}{"example.com", " *.Example.org"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"example.com", " *.Example.org"}, domains)
+	assert.Equal(t, 1, indexOfDomain(domains, "*.example.org"))
+	assert.Equal(t, -1, indexOfDomain(domains, "example.net"))
+
+	domains, err = domainBlocklist(listmonk.Settings{})
+	assert.NoError(t, err)
+	assert.Empty(t, domains)
+
+	_, err = domainBlocklist(listmonk.Settings{domainBlocklistSetting: "example.com"})
+	assert.Error(t, err)
+}
+
+func TestAccDomainBlocklistEntryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_domain_blocklist_entry" "test" {
+					domain = "tf-test-blocklist.example.com"
+				}
+
+				resource "listmonk_domain_blocklist_entry" "wildcard" {
+					domain = "*.tf-test-blocklist.example.org"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_domain_blocklist_entry.test", "id", "tf-test-blocklist.example.com"),
+					resource.TestCheckResourceAttr("listmonk_domain_blocklist_entry.wildcard", "id", "*.tf-test-blocklist.example.org"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "listmonk_domain_blocklist_entry.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// dynamicToGo converts a Terraform value of any type to the plain Go value
+// encoding/json would decode it to: objects and maps become
+// map[string]interface{}, lists, sets and tuples become []interface{} and
+// whole numbers become int64.
+func dynamicToGo(value attr.Value) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return dynamicToGo(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		number := v.ValueBigFloat()
+		if number.IsInt() {
+			if i, accuracy := number.Int64(); accuracy == 0 {
+				return i, nil
+			}
+		}
+		f, _ := number.Float64()
+		return f, nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.ObjectValue:
+		return attributesToGo(v.Attributes())
+	case basetypes.MapValue:
+		return attributesToGo(v.Elements())
+	case basetypes.ListValue:
+		return elementsToGo(v.Elements())
+	case basetypes.SetValue:
+		return elementsToGo(v.Elements())
+	case basetypes.TupleValue:
+		return elementsToGo(v.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func attributesToGo(attributes map[string]attr.Value) (map[string]interface{}, error) {
+	out := make(map[string]interface{}, len(attributes))
+	for k, v := range attributes {
+		converted, err := dynamicToGo(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		out[k] = converted
+	}
+
+	return out, nil
+}
+
+func elementsToGo(elements []attr.Value) ([]interface{}, error) {
+	out := make([]interface{}, 0, len(elements))
+	for i, v := range elements {
+		converted, err := dynamicToGo(v)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		out = append(out, converted)
+	}
+
+	return out, nil
+}
+
+// jsonToDynamic converts a JSON document to a dynamic Terraform value: objects
+// become objects, arrays become tuples and numbers keep their precision.
+// JSON null is converted to a null string, as nested values need a type.
+func jsonToDynamic(ctx context.Context, data []byte) (types.Dynamic, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return types.DynamicNull(), err
+	}
+
+	value, err := goToValue(ctx, decoded)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+
+	return types.DynamicValue(value), nil
+}
+
+// goToValue converts a value decoded by encoding/json with UseNumber to a
+// Terraform value.
+func goToValue(ctx context.Context, value interface{}) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s: %w", v, err)
+		}
+		return types.NumberValue(number), nil
+	case map[string]interface{}:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+		for k, attribute := range v {
+			converted, err := goToValue(ctx, attribute)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attributeTypes[k] = converted.Type(ctx)
+			attributes[k] = converted
+		}
+		object, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert object: %v", diags)
+		}
+		return object, nil
+	case []interface{}:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+		for i, element := range v {
+			converted, err := goToValue(ctx, element)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elementTypes = append(elementTypes, converted.Type(ctx))
+			elements = append(elements, converted)
+		}
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert array: %v", diags)
+		}
+		return tuple, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONStringSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"equal": {
+			prior:    `{"a":1}`,
+			new:      `{"a":1}`,
+			expected: true,
+		},
+		"formatting": {
+			prior:    "{\n  \"a\": [1, 2],\n  \"b\": {\"c\": true}\n}",
+			new:      `{"a":[1,2],"b":{"c":true}}`,
+			expected: true,
+		},
+		"key order": {
+			prior:    `{"a":1,"b":2}`,
+			new:      `{"b":2,"a":1}`,
+			expected: true,
+		},
+		"number formatting": {
+			prior:    `{"a":1.0}`,
+			new:      `{"a":1}`,
+			expected: true,
+		},
+		"array order": {
+			prior:    `[1,2]`,
+			new:      `[2,1]`,
+			expected: false,
+		},
+		"changed value": {
+			prior:    `{"a":1}`,
+			new:      `{"a":2}`,
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := newJSONStringValue(tc.prior).StringSemanticEquals(context.Background(), newJSONStringValue(tc.new))
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expected, equal)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSourceWithConfigure        = &ListDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ListDataSource{}
+)
+
+func NewListDataSource() datasource.DataSource {
+	return &ListDataSource{}
+}
+
+// ListDataSource defines the data source implementation.
+type ListDataSource struct {
+	client *listmonk.Client
+}
+
+// ListDataSourceModel describes the data source data model.
+type ListDataSourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	UUID               types.String `tfsdk:"uuid"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	Optin              types.String `tfsdk:"optin"`
+	Tags               types.Set    `tfsdk:"tags"`
+	Description        types.String `tfsdk:"description"`
+	SubscriberCount    types.Int64  `tfsdk:"subscriber_count"`
+	SubscriberStatuses types.Map    `tfsdk:"subscriber_statuses"`
+}
+
+func (d *ListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_list"
+}
+
+func (d *ListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List data source. Looks up a list by exactly one of `id`, `name` or `uuid`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "List identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "List UUID",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List name. Must match exactly one list",
+				Optional:            true,
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "List created at",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "List updated at",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "List type",
+				Computed:            true,
+			},
+			"optin": schema.StringAttribute{
+				MarkdownDescription: "Opt-in type",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List tags",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "List description",
+				Computed:            true,
+			},
+			"subscriber_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of subscribers in the list",
+				Computed:            true,
+			},
+			"subscriber_statuses": schema.MapAttribute{
+				MarkdownDescription: "Number of subscriptions by status, e.g. `unconfirmed`, `confirmed` and `unsubscribed`",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ListDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("uuid"),
+		),
+	}
+}
+
+func (d *ListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *ListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ListDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the list from the client.
+	list, err := d.findList(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read List, got error: %s", err))
+		return
+	}
+
+	// Set the data source state from the client response.
+	tags, diags := listTagsValue(ctx, list.Tags)
+	resp.Diagnostics.Append(diags...)
+	statuses, diags := listSubscriberStatusesValue(ctx, list.SubscriberStatuses)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.Int64Value(int64(list.ID))
+	data.UUID = types.StringValue(list.UUID)
+	data.CreatedAt = types.StringValue(list.CreatedAt)
+	data.UpdatedAt = types.StringValue(list.UpdatedAt)
+	data.Name = types.StringValue(list.Name)
+	data.Type = types.StringValue(list.Type)
+	data.Optin = types.StringValue(list.Optin)
+	data.Tags = tags
+	data.Description = types.StringValue(list.Description)
+	data.SubscriberCount = types.Int64Value(int64(list.SubscriberCount))
+	data.SubscriberStatuses = statuses
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findList returns the list identified by the configured id, name or uuid.
+func (d *ListDataSource) findList(data ListDataSourceModel) (*listmonk.List, error) {
+	if !data.ID.IsNull() {
+		return d.client.GetList(int(data.ID.ValueInt64()))
+	}
+
+	lists, err := d.client.GetLists(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var list *listmonk.List
+	if !data.Name.IsNull() {
+		list, err = findByName(lists, data.Name.ValueString(), func(l listmonk.List) string { return l.Name })
+		if err != nil {
+			return nil, err
+		}
+		if list == nil {
+			return nil, fmt.Errorf("no list named %q", data.Name.ValueString())
+		}
+	} else {
+		list, err = findByName(lists, data.UUID.ValueString(), func(l listmonk.List) string { return l.UUID })
+		if err != nil {
+			return nil, err
+		}
+		if list == nil {
+			return nil, fmt.Errorf("no list with UUID %q", data.UUID.ValueString())
+		}
+	}
+
+	return list, nil
+}
+
+// listTagsValue converts list tags to a set, treating missing tags as empty.
+func listTagsValue(ctx context.Context, tags []string) (types.Set, diag.Diagnostics) {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, tags)
+}
+
+// listSubscriberStatusesValue converts subscription counts by status to a map.
+func listSubscriberStatusesValue(ctx context.Context, statuses map[string]int) (types.Map, diag.Diagnostics) {
+	counts := make(map[string]int64, len(statuses))
+	for status, count := range statuses {
+		counts[status] = int64(count)
+	}
+
+	return types.MapValueFrom(ctx, types.Int64Type, counts)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccListDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by id testing
+			{
+				Config: providerConfig + `data "listmonk_list" "example" {id = 1}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_list.example", "id", "1"),
+					resource.TestCheckResourceAttr("data.listmonk_list.example", "name", "Default list"),
+					resource.TestCheckResourceAttrSet("data.listmonk_list.example", "uuid"),
+					resource.TestCheckResourceAttrSet("data.listmonk_list.example", "subscriber_count"),
+				),
+			},
+			// Read by name testing
+			{
+				Config: providerConfig + `data "listmonk_list" "example" {name = "Opt-in list"}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_list.example", "id", "2"),
+					resource.TestCheckResourceAttr("data.listmonk_list.example", "optin", "double"),
+				),
+			},
+			// Validation testing
+			{
+				Config:      providerConfig + `data "listmonk_list" "example" {}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
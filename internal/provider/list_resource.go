@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &listResource{}
+	_ resource.ResourceWithConfigure   = &listResource{}
+	_ resource.ResourceWithImportState = &listResource{}
+	_ resource.ResourceWithModifyPlan  = &listResource{}
+)
+
+// NewListResource is a helper function to simplify the provider implementation.
+func NewListResource() resource.Resource {
+	return &listResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *listResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// listResource is the resource implementation.
+type listResource struct {
+	client       *listmonk.Client
+	providerData *ListmonkProviderData
+}
+
+// listResourceModel describes the resource data model.
+type listResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	UUID            types.String `tfsdk:"uuid"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	Optin           types.String `tfsdk:"optin"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TagsAll         types.Set    `tfsdk:"tags_all"`
+	Description     types.String `tfsdk:"description"`
+	SubscriberCount types.Int64  `tfsdk:"subscriber_count"`
+	// DeletionProtection and AllowDeleteWithSubscribers are only used on deletion.
+	DeletionProtection         types.Bool `tfsdk:"deletion_protection"`
+	AllowDeleteWithSubscribers types.Bool `tfsdk:"allow_delete_with_subscribers"`
+	// AdoptExisting is only used on creation.
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
+// Metadata returns the resource type name.
+func (l *listResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_list"
+}
+
+// Schema defines the schema for the resource.
+func (l *listResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Mailing list",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "List identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "List UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "List created at",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "List updated at",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List name",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "List type. One of `public`, `private` or `temporary`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "private", "temporary"),
+				},
+			},
+			"optin": schema.StringAttribute{
+				MarkdownDescription: "Opt-in type. One of `single` or `double`. Defaults to `single`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("single"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("single", "double"),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List tags. The provider's `default_tags` are added to these in listmonk",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"tags_all": tagsAllAttribute(),
+			"description": schema.StringAttribute{
+				MarkdownDescription: "List description",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"subscriber_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of subscribers in the list",
+				Computed:            true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Refuse to delete the list while it has subscribers, as deleting a list drops all its subscriptions. Empty lists can always be deleted. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"allow_delete_with_subscribers": schema.BoolAttribute{
+				MarkdownDescription: "Allow deleting the list while it has subscribers despite `deletion_protection`. Must be applied before the list is destroyed. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"adopt_existing": adoptExistingAttribute("list"),
+		},
+	}
+}
+
+// ModifyPlan merges the provider's default tags into tags_all.
+func (l *listResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan listResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := mergeDefaultTags(ctx, plan.Tags, l.defaultTags())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// defaultTags returns the provider's default tags, if the provider is configured.
+func (l *listResource) defaultTags() []string {
+	if l.providerData == nil {
+		return nil
+	}
+
+	return l.providerData.DefaultTags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (l *listResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan listResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	list, diags := plan.toList(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the resource, or update an adopted one
+	r, diags := createOrAdoptList(ctx, l.client, shouldAdoptExisting(plan.AdoptExisting, l.providerData), list)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(r.ID))
+	plan.UUID = types.StringValue(r.UUID)
+	plan.CreatedAt = types.StringValue(r.CreatedAt)
+	plan.UpdatedAt = types.StringValue(r.UpdatedAt)
+	plan.SubscriberCount = types.Int64Value(int64(r.SubscriberCount))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (l *listResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state listResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "id", state.ID)
+	tflog.Info(ctx, "Reading list")
+
+	// Get refreshed list value from Listmonk
+	list, err := l.client.GetList(int(state.ID.ValueInt64()))
+	if listmonk.IsNotFound(err) {
+		tflog.Warn(ctx, "List not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read list",
+			fmt.Sprintf("Failed to read list: %s", err),
+		)
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(state.fromList(ctx, list, l.defaultTags())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (l *listResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan listResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	list, diags := plan.toList(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	list.ID = int(plan.ID.ValueInt64())
+
+	// Update existing list
+	r, err := l.client.UpdateList(list)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update list",
+			fmt.Sprintf("Failed to update list: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.UpdatedAt = types.StringValue(r.UpdatedAt)
+	plan.SubscriberCount = types.Int64Value(int64(r.SubscriberCount))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (l *listResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state listResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the list's current subscribers, as the state may be outdated
+	list, err := l.client.GetList(int(state.ID.ValueInt64()))
+	if listmonk.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete list",
+			fmt.Sprintf("Failed to read list: %s", err),
+		)
+		return
+	}
+
+	if list.SubscriberCount > 0 && state.DeletionProtection.ValueBool() && !state.AllowDeleteWithSubscribers.ValueBool() {
+		resp.Diagnostics.AddError(
+			"List has subscribers",
+			fmt.Sprintf("Deleting list %d (%q) would drop the subscriptions of %d subscribers. "+
+				"Remove the subscribers first, or set allow_delete_with_subscribers = true and apply before destroying it.",
+				list.ID, list.Name, list.SubscriberCount),
+		)
+		return
+	}
+
+	// Delete existing list
+	err = l.client.DeleteList(int(state.ID.ValueInt64()))
+	if err != nil && !listmonk.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to delete list",
+			fmt.Sprintf("Failed to delete list: %s", err),
+		)
+		return
+	}
+}
+
+// ImportState imports a list by its ID or, if the import ID is not a number,
+// by its name.
+func (l *listResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	listId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		lists, err := l.client.GetLists(nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to import list",
+				fmt.Sprintf("Failed to look up lists: %s", err),
+			)
+			return
+		}
+		list, err := findByName(lists, req.ID, func(l listmonk.List) string { return l.Name })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to import list",
+				fmt.Sprintf("Unable to import list: %s", err),
+			)
+			return
+		}
+		if list == nil {
+			resp.Diagnostics.AddError(
+				"Unable to import list",
+				fmt.Sprintf("Import ID %q is neither a list ID nor the name of an existing list.", req.ID),
+			)
+			return
+		}
+		listId = int64(list.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), listId)...)
+	// Imported lists get the same protection as newly created ones
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_delete_with_subscribers"), false)...)
+}
+
+// toList converts the model to a listmonk list without its ID.
+func (m *listResourceModel) toList(ctx context.Context) (*listmonk.List, diag.Diagnostics) {
+	tags := []string{}
+	diags := m.TagsAll.ElementsAs(ctx, &tags, false)
+
+	return &listmonk.List{
+		Name:        m.Name.ValueString(),
+		Type:        m.Type.ValueString(),
+		Optin:       m.Optin.ValueString(),
+		Tags:        tags,
+		Description: m.Description.ValueString(),
+	}, diags
+}
+
+// fromList sets the model's attributes from a listmonk list, except the ones
+// only used on creation and deletion.
+func (m *listResourceModel) fromList(ctx context.Context, list *listmonk.List, defaultTags []string) diag.Diagnostics {
+	tagsAllValue, diags := listTagsValue(ctx, list.Tags)
+	tagsValue, d := withoutDefaultTags(ctx, list.Tags, m.Tags, defaultTags)
+	diags.Append(d...)
+
+	m.ID = types.Int64Value(int64(list.ID))
+	m.UUID = types.StringValue(list.UUID)
+	m.CreatedAt = types.StringValue(list.CreatedAt)
+	m.UpdatedAt = types.StringValue(list.UpdatedAt)
+	m.Name = types.StringValue(list.Name)
+	m.Type = types.StringValue(list.Type)
+	m.Optin = types.StringValue(list.Optin)
+	m.Tags = tagsValue
+	m.TagsAll = tagsAllValue
+	m.Description = types.StringValue(list.Description)
+	m.SubscriberCount = types.Int64Value(int64(list.SubscriberCount))
+
+	return diags
+}
+
+// createOrAdoptList creates the list, or updates an existing list with the
+// same name if adopt is set.
+func createOrAdoptList(ctx context.Context, client *listmonk.Client, adopt bool, list *listmonk.List) (*listmonk.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Look up an existing list with the same name to adopt
+	if adopt {
+		lists, err := client.GetLists(nil)
+		if err != nil {
+			diags.AddError(
+				"Failed to create list",
+				fmt.Sprintf("Failed to look up existing lists: %s", err),
+			)
+			return nil, diags
+		}
+		existing, err := findByName(lists, list.Name, func(l listmonk.List) string { return l.Name })
+		if err != nil {
+			diags.AddError(
+				"Failed to adopt existing list",
+				fmt.Sprintf("Failed to adopt existing list: %s", err),
+			)
+			return nil, diags
+		}
+		if existing != nil {
+			tflog.Info(ctx, "Adopting existing list", map[string]interface{}{"id": existing.ID})
+			list.ID = existing.ID
+			r, err := client.UpdateList(list)
+			if err != nil {
+				diags.AddError(
+					"Failed to adopt existing list",
+					fmt.Sprintf("Failed to update existing list: %s", err),
+				)
+			}
+			return r, diags
+		}
+	}
+
+	r, err := client.CreateList(list)
+	if err != nil {
+		diags.AddError(
+			"Failed to create list",
+			fmt.Sprintf("Failed to create list: %s", err),
+		)
+	}
+
+	return r, diags
+}
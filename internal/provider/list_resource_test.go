@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list"
+					type = "secret"
+				}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list"
+					type = "public"
+					tags = ["tf", "test"]
+
+					deletion_protection = false
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list.test", "name", "tf-test-list"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "type", "public"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "optin", "single"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "description", ""),
+					resource.TestCheckResourceAttr("listmonk_list.test", "subscriber_count", "0"),
+					resource.TestCheckResourceAttrSet("listmonk_list.test", "id"),
+					resource.TestCheckResourceAttrSet("listmonk_list.test", "uuid"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "listmonk_list.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at", "deletion_protection"},
+			},
+			// ImportState by name testing
+			{
+				ResourceName:            "listmonk_list.test",
+				ImportState:             true,
+				ImportStateId:           "tf-test-list",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at", "deletion_protection"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name        = "tf-test-list"
+					type        = "private"
+					optin       = "double"
+					description = "Managed by terraform"
+
+					deletion_protection = false
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list.test", "type", "private"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "optin", "double"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "description", "Managed by terraform"),
+				),
+			},
+			// Read removes lists deleted outside of terraform
+			{
+				PreConfig: func() {
+					client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+					lists, err := client.GetLists(nil)
+					require.NoError(t, err)
+					for _, list := range lists {
+						if list.Name == "tf-test-list" {
+							require.NoError(t, client.DeleteList(list.ID))
+						}
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if _, ok := s.RootModule().Resources["listmonk_list.test"]; ok {
+						return fmt.Errorf("expected deleted list to be removed from state")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccListResourceDeletionProtection(t *testing.T) {
+	client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+	var listID, subscriberID int
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if subscriberID != 0 {
+				require.NoError(t, client.DeleteSubscriber(subscriberID))
+			}
+			_, err := client.GetList(listID)
+			if !listmonk.IsNotFound(err) {
+				return fmt.Errorf("expected list %d to be deleted, got: %v", listID, err)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list-protected"
+					type = "private"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list.test", "deletion_protection", "true"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "allow_delete_with_subscribers", "false"),
+					resource.TestCheckResourceAttrWith("listmonk_list.test", "id", func(value string) error {
+						var err error
+						listID, err = strconv.Atoi(value)
+						return err
+					}),
+				),
+			},
+			// deletion_protection refuses to destroy a list with subscribers
+			{
+				PreConfig: func() {
+					subscriber, err := client.CreateSubscriber(&listmonk.SubscriberRequest{
+						Email:  "tf-test-list-protected@example.com",
+						Name:   "tf-test-list-protected",
+						Status: "enabled",
+						Lists:  []int{listID},
+					})
+					require.NoError(t, err)
+					subscriberID = subscriber.ID
+				},
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list-protected"
+					type = "private"
+				}
+`,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`List has subscribers`),
+			},
+			// allow_delete_with_subscribers lets the list be destroyed, see CheckDestroy
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list-protected"
+					type = "private"
+
+					allow_delete_with_subscribers = true
+				}
+`,
+				Check: resource.TestCheckResourceAttr("listmonk_list.test", "allow_delete_with_subscribers", "true"),
+			},
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list-protected"
+					type = "private"
+
+					allow_delete_with_subscribers = true
+				}
+`,
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestAccListResourceDeletionProtectionEmpty(t *testing.T) {
+	client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+	var listID int
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			_, err := client.GetList(listID)
+			if !listmonk.IsNotFound(err) {
+				return fmt.Errorf("expected empty list %d to be deleted, got: %v", listID, err)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Empty lists are destroyed despite deletion_protection
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list-empty"
+					type = "private"
+				}
+`,
+				Check: resource.TestCheckResourceAttrWith("listmonk_list.test", "id", func(value string) error {
+					var err error
+					listID, err = strconv.Atoi(value)
+					return err
+				}),
+			},
+		},
+	})
+}
+
+func TestAccListResourceDefaultTags(t *testing.T) {
+	providerConfigWithDefaultTags := func(defaultTags string) string {
+		return fmt.Sprintf(`
+		provider "listmonk" {
+			host         = "http://localhost:%s"
+			username     = "listmonk"
+			password     = "listmonk"
+			default_tags = %s
+		}
+`, dockerClient.ContainerPort, defaultTags)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with default tags
+			{
+				Config: providerConfigWithDefaultTags(`["managed-by:terraform"]`) + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list-tags"
+					type = "private"
+					tags = ["newsletter"]
+
+					deletion_protection = false
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "tags_all.#", "2"),
+					resource.TestCheckTypeSetElemAttr("listmonk_list.test", "tags_all.*", "managed-by:terraform"),
+					resource.TestCheckTypeSetElemAttr("listmonk_list.test", "tags_all.*", "newsletter"),
+				),
+			},
+			// Changing the default tags updates tags_all only
+			{
+				Config: providerConfigWithDefaultTags(`["managed-by:terraform", "team:growth"]`) + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list-tags"
+					type = "private"
+					tags = ["newsletter"]
+
+					deletion_protection = false
+				}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_list.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "tags_all.#", "3"),
+					resource.TestCheckTypeSetElemAttr("listmonk_list.test", "tags_all.*", "team:growth"),
+				),
+			},
+			// Default tags that are also resource tags are kept in tags
+			{
+				Config: providerConfigWithDefaultTags(`["managed-by:terraform", "team:growth"]`) + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-list-tags"
+					type = "private"
+					tags = ["newsletter", "team:growth"]
+
+					deletion_protection = false
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("listmonk_list.test", "tags_all.#", "3"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &listSubscriptionResource{}
+	_ resource.ResourceWithConfigure   = &listSubscriptionResource{}
+	_ resource.ResourceWithImportState = &listSubscriptionResource{}
+)
+
+// NewListSubscriptionResource is a helper function to simplify the provider implementation.
+func NewListSubscriptionResource() resource.Resource {
+	return &listSubscriptionResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *listSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// listSubscriptionResource is the resource implementation.
+type listSubscriptionResource struct {
+	client *listmonk.Client
+}
+
+// listSubscriptionResourceModel describes the resource data model.
+type listSubscriptionResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	SubscriberID types.Int64  `tfsdk:"subscriber_id"`
+	ListID       types.Int64  `tfsdk:"list_id"`
+	Status       types.String `tfsdk:"status"`
+}
+
+// Metadata returns the resource type name.
+func (l *listSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_list_subscription"
+}
+
+// Schema defines the schema for the resource.
+func (l *listSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Subscription of a subscriber to a list. Do not combine with the `lists` attribute of `listmonk_subscriber` for the same subscriber",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Subscription identifier in the form `<subscriber_id>:<list_id>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_id": schema.Int64Attribute{
+				MarkdownDescription: "Subscriber identifier",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"list_id": schema.Int64Attribute{
+				MarkdownDescription: "List identifier",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Subscription status. One of `unconfirmed`, `confirmed` or `unsubscribed`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("unconfirmed", "confirmed", "unsubscribed"),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (l *listSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan listSubscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new subscription
+	err := l.setSubscription(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create list subscription",
+			fmt.Sprintf("Failed to create list subscription: %s", err),
+		)
+		return
+	}
+
+	// Populate Computed attribute values
+	plan.ID = types.StringValue(fmt.Sprintf("%d:%d", plan.SubscriberID.ValueInt64(), plan.ListID.ValueInt64()))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (l *listSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state listSubscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "id", state.ID)
+	tflog.Info(ctx, "Reading list subscription")
+
+	// Get the subscriber's subscriptions from Listmonk
+	subscriber, err := l.client.GetSubscriber(int(state.SubscriberID.ValueInt64()))
+	if listmonk.IsNotFound(err) {
+		tflog.Warn(ctx, "Subscriber not found, removing list subscription from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read list subscription",
+			fmt.Sprintf("Failed to read subscriber: %s", err),
+		)
+
+		return
+	}
+
+	var subscription *listmonk.SubscriberList
+	for i := range subscriber.Lists {
+		if int64(subscriber.Lists[i].ID) == state.ListID.ValueInt64() {
+			subscription = &subscriber.Lists[i]
+		}
+	}
+	if subscription == nil {
+		tflog.Warn(ctx, "List subscription not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Status = types.StringValue(subscription.SubscriptionStatus)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (l *listSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan listSubscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing subscription
+	err := l.setSubscription(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update list subscription",
+			fmt.Sprintf("Failed to update list subscription: %s", err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (l *listSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state listSubscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the subscriber from the list
+	err := l.client.UpdateSubscriberLists(&listmonk.SubscriberListsRequest{
+		IDs:           []int{int(state.SubscriberID.ValueInt64())},
+		Action:        "remove",
+		TargetListIDs: []int{int(state.ListID.ValueInt64())},
+	})
+	if err != nil && !listmonk.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to delete list subscription",
+			fmt.Sprintf("Failed to delete list subscription: %s", err),
+		)
+		return
+	}
+}
+
+// ImportState imports a subscription by `<subscriber_id>:<list_id>`.
+func (l *listSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	subscriberPart, listPart, ok := strings.Cut(req.ID, ":")
+	subscriberId, err := strconv.ParseInt(subscriberPart, 10, 64)
+	if ok && err == nil {
+		var listId int64
+		listId, err = strconv.ParseInt(listPart, 10, 64)
+		if err == nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subscriber_id"), subscriberId)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("list_id"), listId)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Unable to parse list subscription ID (importing)",
+		fmt.Sprintf("Import ID %q must have the form <subscriber_id>:<list_id>.", req.ID),
+	)
+}
+
+// setSubscription subscribes the subscriber to the list with the planned
+// status. listmonk updates the status of existing subscriptions, and only
+// existing subscriptions can be unsubscribed, so a missing subscription is
+// added as unconfirmed first.
+func (l *listSubscriptionResource) setSubscription(plan listSubscriptionResourceModel) error {
+	subscriberID := int(plan.SubscriberID.ValueInt64())
+	listID := int(plan.ListID.ValueInt64())
+	request := listmonk.SubscriberListsRequest{
+		IDs:           []int{subscriberID},
+		Action:        "add",
+		TargetListIDs: []int{listID},
+		Status:        plan.Status.ValueString(),
+	}
+	if plan.Status.ValueString() != "unsubscribed" {
+		return l.client.UpdateSubscriberLists(&request)
+	}
+
+	subscriber, err := l.client.GetSubscriber(subscriberID)
+	if err != nil {
+		return err
+	}
+	subscribed := false
+	for _, list := range subscriber.Lists {
+		if list.ID == listID {
+			subscribed = true
+		}
+	}
+	if !subscribed {
+		request.Status = "unconfirmed"
+		err = l.client.UpdateSubscriberLists(&request)
+		if err != nil {
+			return err
+		}
+	}
+
+	request.Action = "unsubscribe"
+	request.Status = ""
+	err = l.client.UpdateSubscriberLists(&request)
+	if err != nil && !subscribed {
+		return fmt.Errorf("the subscription was added as unconfirmed, but unsubscribing failed: %w", err)
+	}
+
+	return err
+}
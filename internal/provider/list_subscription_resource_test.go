@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccListSubscriptionResource(t *testing.T) {
+	config := func(status string) string {
+		return providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email = "tf-test-subscription@example.com"
+				}
+
+				resource "listmonk_list_subscription" "test" {
+					subscriber_id = listmonk_subscriber.test.id
+					list_id       = 2
+					status        = "` + status + `"
+				}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config:      config("pending"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			// Create and Read testing
+			{
+				Config: config("unconfirmed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list_subscription.test", "status", "unconfirmed"),
+					resource.TestCheckResourceAttrSet("listmonk_list_subscription.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "listmonk_list_subscription.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: config("confirmed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_list_subscription.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list_subscription.test", "status", "confirmed"),
+				),
+			},
+			{
+				Config: config("unsubscribed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_list_subscription.test", "status", "unsubscribed"),
+				),
+			},
+		},
+	})
+}
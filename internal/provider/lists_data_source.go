@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &ListsDataSource{}
+)
+
+func NewListsDataSource() datasource.DataSource {
+	return &ListsDataSource{}
+}
+
+// ListsDataSource defines the data source implementation.
+type ListsDataSource struct {
+	client *listmonk.Client
+}
+
+// ListsDataSourceModel describes the data source data model.
+type ListsDataSourceModel struct {
+	Query types.String               `tfsdk:"query"`
+	Tags  []types.String             `tfsdk:"tags"`
+	Type  types.String               `tfsdk:"type"`
+	Lists []ListsDataSourceItemModel `tfsdk:"lists"`
+}
+
+// ListsDataSourceItemModel describes a single list returned by the data source.
+type ListsDataSourceItemModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	UUID               types.String `tfsdk:"uuid"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	Optin              types.String `tfsdk:"optin"`
+	Tags               types.Set    `tfsdk:"tags"`
+	Description        types.String `tfsdk:"description"`
+	SubscriberCount    types.Int64  `tfsdk:"subscriber_count"`
+	SubscriberStatuses types.Map    `tfsdk:"subscriber_statuses"`
+}
+
+func (d *ListsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lists"
+}
+
+func (d *ListsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists data source. Returns all lists, optionally filtered by name, tags and type.",
+
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Only return lists whose name matches this listmonk search query",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Only return lists with these tags",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return lists of this type. One of `public`, `private` or `temporary`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "private", "temporary"),
+				},
+			},
+			"lists": schema.ListNestedAttribute{
+				MarkdownDescription: "Lists matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "List identifier",
+							Computed:            true,
+						},
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "List UUID",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "List created at",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "List updated at",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "List name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "List type",
+							Computed:            true,
+						},
+						"optin": schema.StringAttribute{
+							MarkdownDescription: "Opt-in type",
+							Computed:            true,
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "List tags",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "List description",
+							Computed:            true,
+						},
+						"subscriber_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of subscribers in the list",
+							Computed:            true,
+						},
+						"subscriber_statuses": schema.MapAttribute{
+							MarkdownDescription: "Number of subscriptions by status, e.g. `unconfirmed`, `confirmed` and `unsubscribed`",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ListsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *ListsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ListsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if !data.Query.IsNull() {
+		query.Set("query", data.Query.ValueString())
+	}
+	for _, tag := range data.Tags {
+		query.Add("tag", tag.ValueString())
+	}
+
+	// Get the lists from the client.
+	lists, err := d.client.GetLists(query)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Lists, got error: %s", err))
+		return
+	}
+
+	// Set the data source state from the client response.
+	data.Lists = []ListsDataSourceItemModel{}
+	for _, list := range lists {
+		// listmonk has no type filter, so lists are filtered here.
+		if !data.Type.IsNull() && list.Type != data.Type.ValueString() {
+			continue
+		}
+
+		tags, diags := listTagsValue(ctx, list.Tags)
+		resp.Diagnostics.Append(diags...)
+		statuses, diags := listSubscriberStatusesValue(ctx, list.SubscriberStatuses)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Lists = append(data.Lists, ListsDataSourceItemModel{
+			ID:                 types.Int64Value(int64(list.ID)),
+			UUID:               types.StringValue(list.UUID),
+			CreatedAt:          types.StringValue(list.CreatedAt),
+			UpdatedAt:          types.StringValue(list.UpdatedAt),
+			Name:               types.StringValue(list.Name),
+			Type:               types.StringValue(list.Type),
+			Optin:              types.StringValue(list.Optin),
+			Tags:               tags,
+			Description:        types.StringValue(list.Description),
+			SubscriberCount:    types.Int64Value(int64(list.SubscriberCount)),
+			SubscriberStatuses: statuses,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccListsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "listmonk_lists" "example" {
+					type = "public"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_lists.example", "lists.#", "1"),
+					resource.TestCheckResourceAttr("data.listmonk_lists.example", "lists.0.name", "Opt-in list"),
+					resource.TestCheckResourceAttrSet("data.listmonk_lists.example", "lists.0.subscriber_count"),
+				),
+			},
+			// Query testing
+			{
+				Config: providerConfig + `
+				data "listmonk_lists" "example" {
+					query = "Default"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_lists.example", "lists.#", "1"),
+					resource.TestCheckResourceAttr("data.listmonk_lists.example", "lists.0.id", "1"),
+				),
+			},
+		},
+	})
+}
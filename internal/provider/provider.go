@@ -8,6 +8,7 @@ import (
 	"terraform-provider-listmonk/internal/listmonk"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,7 +17,10 @@ import (
 )
 
 // Ensure ListmonkProvider satisfies various provider interfaces.
-var _ provider.Provider = &ListmonkProvider{}
+var (
+	_ provider.Provider              = &ListmonkProvider{}
+	_ provider.ProviderWithFunctions = &ListmonkProvider{}
+)
 
 // ListmonkProvider defines the provider implementation.
 type ListmonkProvider struct {
@@ -28,10 +32,23 @@ type ListmonkProvider struct {
 
 // ListmonkProviderModel describes the provider data model.
 type ListmonkProviderModel struct {
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Headers  types.Map    `tfsdk:"headers"`
+	Host          types.String `tfsdk:"host"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	Headers       types.Map    `tfsdk:"headers"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	DefaultTags   types.Set    `tfsdk:"default_tags"`
+}
+
+// ListmonkProviderData is passed to resources and data sources when they are
+// configured.
+type ListmonkProviderData struct {
+	Client *listmonk.Client
+	// AdoptExisting is the provider-wide default for adopting existing
+	// objects with the same name on create.
+	AdoptExisting bool
+	// DefaultTags are merged into the tags of every taggable resource.
+	DefaultTags []string
 }
 
 func (p *ListmonkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -61,6 +78,17 @@ func (p *ListmonkProvider) Schema(ctx context.Context, req provider.SchemaReques
 				ElementType: types.StringType,
 				Sensitive:   true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional: true,
+				Description: "Take over existing objects with the same name instead of creating duplicates when resources are created. " +
+					"Can be overridden per resource. Defaults to `false`",
+			},
+			"default_tags": schema.SetAttribute{
+				Optional: true,
+				Description: "Tags added to every taggable object managed by the provider, such as lists. " +
+					"The tags of an object including these are exposed as `tags_all`. Example: `[\"managed-by:terraform\"]`",
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -81,6 +109,19 @@ func (p *ListmonkProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unknown listmonk default tags",
+			"The default tags must be known when planning",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defaultTags := []string{}
+	resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -96,19 +137,46 @@ func (p *ListmonkProvider) Configure(ctx context.Context, req provider.Configure
 		config.Password.ValueString(),
 		headers,
 	)
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	providerData := &ListmonkProviderData{
+		Client:        client,
+		AdoptExisting: config.AdoptExisting.ValueBool(),
+		DefaultTags:   defaultTags,
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *ListmonkProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewTemplateResource,
+		NewCampaignTemplateResource,
+		NewTxTemplateResource,
+		NewListResource,
+		NewSubscriberResource,
+		NewListSubscriptionResource,
+		NewSubscriberSetResource,
+		NewSubscriberImportResource,
+		NewSubscriberBlocklistResource,
+		NewDomainBlocklistEntryResource,
+		NewSubscriberQueryOperationResource,
+		NewSubscriberOptinResource,
 	}
 }
 
 func (p *ListmonkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewTemplateDataSource,
+		NewTemplatesDataSource,
+		NewListDataSource,
+		NewListsDataSource,
+		NewSubscribersDataSource,
+		NewSubscriberExportDataSource,
+	}
+}
+
+func (p *ListmonkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewRenderTemplateFunction,
 	}
 }
 
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &renderTemplateFunction{}
+)
+
+func NewRenderTemplateFunction() function.Function {
+	return &renderTemplateFunction{}
+}
+
+// renderTemplateFunction renders listmonk templates without a server.
+type renderTemplateFunction struct{}
+
+func (f *renderTemplateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_template"
+}
+
+func (f *renderTemplateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render a listmonk template locally",
+		MarkdownDescription: "Renders a listmonk template body with listmonk's template functions (including the sprig functions) " +
+			"against a sample subscriber and campaign, without contacting a server. " +
+			"`data` overrides parts of the sample context using the field names of the listmonk API, e.g. " +
+			"`{ subscriber = { name = \"Jane\", attribs = { city = \"Berlin\" } }, campaign = { subject = \"Hi\", body = \"<p>Content</p>\" }, tx = { data = { order = 1 } }, root_url = \"https://listmonk.example.com\" }`. " +
+			"The campaign body is rendered where the template includes `{{ template \"content\" . }}`. " +
+			"Links are built from `root_url`, `TrackLink` returns links unchanged and `L.T` returns translation keys as is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "body",
+				MarkdownDescription: "Template body",
+			},
+			function.DynamicParameter{
+				Name:                "data",
+				MarkdownDescription: "Values overriding the sample context, or `null`",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *renderTemplateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var body string
+	var data types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &body, &data))
+	if resp.Error != nil {
+		return
+	}
+
+	value, err := dynamicToGo(data)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Unable to read data: "+err.Error())
+		return
+	}
+	dataMap, ok := value.(map[string]interface{})
+	if value != nil && !ok {
+		resp.Error = function.NewArgumentFuncError(1, "data must be an object")
+		return
+	}
+
+	renderContext, err := listmonk.NewRenderContext(dataMap)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid data: "+err.Error())
+		return
+	}
+
+	out, err := listmonk.RenderTemplate(body, renderContext)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Unable to render template: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, out))
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestRenderTemplateFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::listmonk::render_template(
+						"<div>{{ template \"content\" . }}</div><p>{{ .Subscriber.Attribs.city }}</p>",
+						{
+							subscriber = { name = "Jane Doe", attribs = { city = "Berlin" } }
+							campaign   = { body = "<p>Hi {{ .Subscriber.FirstName }}</p>" }
+						}
+					)
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "<div><p>Hi Jane</p></div><p>Berlin</p>"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::listmonk::render_template("{{ .Subscriber.Name }}", null)
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Sample Subscriber"),
+				),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::listmonk::render_template("{{ .Subscriber.Name ", null)
+				}
+`,
+				ExpectError: regexp.MustCompile("Unable to render template"),
+			},
+		},
+	})
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &subscriberBlocklistResource{}
+	_ resource.ResourceWithConfigure        = &subscriberBlocklistResource{}
+	_ resource.ResourceWithConfigValidators = &subscriberBlocklistResource{}
+)
+
+// NewSubscriberBlocklistResource is a helper function to simplify the provider implementation.
+func NewSubscriberBlocklistResource() resource.Resource {
+	return &subscriberBlocklistResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subscriberBlocklistResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// subscriberBlocklistResource is the resource implementation.
+type subscriberBlocklistResource struct {
+	client *listmonk.Client
+}
+
+// subscriberBlocklistResourceModel describes the resource data model.
+type subscriberBlocklistResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	SubscriberIDs  types.Set    `tfsdk:"subscriber_ids"`
+	Query          types.String `tfsdk:"query"`
+	ListIDs        types.Set    `tfsdk:"list_ids"`
+	NotBlocklisted types.Int64  `tfsdk:"not_blocklisted"`
+}
+
+// Metadata returns the resource type name.
+func (s *subscriberBlocklistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriber_blocklist"
+}
+
+// Schema defines the schema for the resource.
+func (s *subscriberBlocklistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Blocklists subscribers selected by ID or by query, and keeps them blocklisted: subscribers that are " +
+			"enabled again or that start matching the query are blocklisted on the next apply. " +
+			"Subscribers stay blocklisted when they are removed from the selection or the resource is destroyed",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Blocklist identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_ids": schema.SetAttribute{
+				MarkdownDescription: "Subscribers to blocklist. Exactly one of `subscriber_ids` or `query` must be set",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "listmonk SQL expression selecting the subscribers to blocklist, " +
+					"for example `subscribers.email LIKE '%@example.com'`. Exactly one of `subscriber_ids` or `query` must be set",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"list_ids": schema.SetAttribute{
+				MarkdownDescription: "Only blocklist subscribers matching `query` in these lists",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("query")),
+				},
+			},
+			"not_blocklisted": schema.Int64Attribute{
+				MarkdownDescription: "Number of selected subscribers that are not blocklisted. Always 0 after apply; " +
+					"a different value is planned back to 0 by blocklisting them",
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+			},
+		},
+	}
+}
+
+// ConfigValidators checks that the subscribers are selected once.
+func (s *subscriberBlocklistResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("subscriber_ids"),
+			path.MatchRoot("query"),
+		),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (s *subscriberBlocklistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subscriberBlocklistResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(s.blocklist(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate Computed attribute values
+	query, listIDs, diags := plan.selection(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(subscriberSelectionID(query, listIDs))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (s *subscriberBlocklistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subscriberBlocklistResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "id", state.ID)
+	tflog.Info(ctx, "Reading subscriber blocklist")
+
+	query, listIDs, diags := state.selection(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	total, err := countSubscribers(s.client, "("+query+") AND subscribers.status != 'blocklisted'", listIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read subscriber blocklist",
+			fmt.Sprintf("Failed to count subscribers that are not blocklisted: %s", err),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.NotBlocklisted = types.Int64Value(int64(total))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (s *subscriberBlocklistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan subscriberBlocklistResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(s.blocklist(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the state. Subscribers stay blocklisted.
+func (s *subscriberBlocklistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// blocklist blocklists the selected subscribers.
+func (s *subscriberBlocklistResource) blocklist(ctx context.Context, plan subscriberBlocklistResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var err error
+	if !plan.SubscriberIDs.IsNull() {
+		var ids []int64
+		diags.Append(plan.SubscriberIDs.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		subscriberIDs := make([]int, 0, len(ids))
+		for _, id := range ids {
+			subscriberIDs = append(subscriberIDs, int(id))
+		}
+		tflog.Info(ctx, "Blocklisting subscribers", map[string]interface{}{"subscriber_ids": subscriberIDs})
+		err = s.client.BlocklistSubscribers(subscriberIDs)
+	} else {
+		_, listIDs, d := plan.selection(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		tflog.Info(ctx, "Blocklisting subscribers by query", map[string]interface{}{"query": plan.Query.ValueString()})
+		err = s.client.BlocklistSubscribersByQuery(&listmonk.SubscriberQueryRequest{
+			Query:   plan.Query.ValueString(),
+			ListIDs: listIDs,
+		})
+	}
+	if err != nil {
+		diags.AddError(
+			"Failed to blocklist subscribers",
+			fmt.Sprintf("Failed to blocklist subscribers: %s", err),
+		)
+	}
+
+	return diags
+}
+
+// selection returns the query and lists selecting the subscribers, turning
+// subscriber_ids into a query.
+func (m subscriberBlocklistResourceModel) selection(ctx context.Context) (string, []int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !m.SubscriberIDs.IsNull() {
+		var ids []int64
+		diags.Append(m.SubscriberIDs.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return "", nil, diags
+		}
+		return subscriberIDsQuery(ids), []int{}, diags
+	}
+
+	listIDs, d := int64SetToInts(ctx, m.ListIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return "", nil, diags
+	}
+
+	return m.Query.ValueString(), listIDs, diags
+}
+
+// subscriberIDsQuery returns a listmonk SQL expression selecting subscribers by ID.
+func subscriberIDsQuery(ids []int64) string {
+	sorted := append([]int64(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	values := make([]string, 0, len(sorted))
+	for _, id := range sorted {
+		values = append(values, strconv.FormatInt(id, 10))
+	}
+	return "subscribers.id IN (" + strings.Join(values, ",") + ")"
+}
+
+// subscriberSelectionID derives an identifier from a selection of
+// subscribers at creation.
+func subscriberSelectionID(query string, listIDs []int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\n%v", query, listIDs)))
+	return hex.EncodeToString(sum[:8])
+}
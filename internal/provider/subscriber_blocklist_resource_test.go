@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriberIDsQuery(t *testing.T) {
+	assert.Equal(t, "subscribers.id IN (1,2,10)", subscriberIDsQuery([]int64{10, 2, 1}))
+}
+
+func TestAccSubscriberBlocklistResource(t *testing.T) {
+	client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+	var subscriberID int
+	checkBlocklisted := func(s *terraform.State) error {
+		subscriber, err := client.GetSubscriber(subscriberID)
+		if err != nil {
+			return err
+		}
+		if subscriber.Status != "blocklisted" {
+			return fmt.Errorf("expected subscriber %d to be blocklisted, got status %s", subscriberID, subscriber.Status)
+		}
+		return nil
+	}
+	config := providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email = "tf-test-blocklist@example.com"
+
+					lifecycle {
+						ignore_changes = [status]
+					}
+				}
+
+				resource "listmonk_subscriber_blocklist" "test" {
+					subscriber_ids = [listmonk_subscriber.test.id]
+				}
+
+				resource "listmonk_subscriber_blocklist" "query" {
+					query = "subscribers.email LIKE 'tf-test-blocklist-%@example.com'"
+				}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber_blocklist" "test" {
+					subscriber_ids = [1]
+					query          = "subscribers.id = 1"
+				}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("listmonk_subscriber.test", "id", func(value string) error {
+						var err error
+						subscriberID, err = strconv.Atoi(value)
+						return err
+					}),
+					checkBlocklisted,
+					resource.TestCheckResourceAttr("listmonk_subscriber_blocklist.test", "not_blocklisted", "0"),
+					resource.TestCheckResourceAttrSet("listmonk_subscriber_blocklist.test", "id"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_blocklist.query", "not_blocklisted", "0"),
+				),
+			},
+			// Subscribers enabled outside of terraform are blocklisted again
+			{
+				PreConfig: func() {
+					subscriber, err := client.GetSubscriber(subscriberID)
+					require.NoError(t, err)
+					_, err = client.UpdateSubscriber(subscriberID, &listmonk.SubscriberRequest{
+						Email:   subscriber.Email,
+						Name:    subscriber.Name,
+						Status:  "enabled",
+						Lists:   []int{},
+						Attribs: subscriber.Attribs,
+					})
+					require.NoError(t, err)
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_subscriber_blocklist.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkBlocklisted,
+					resource.TestCheckResourceAttr("listmonk_subscriber_blocklist.test", "not_blocklisted", "0"),
+				),
+			},
+		},
+	})
+}
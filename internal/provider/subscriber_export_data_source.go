@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &SubscriberExportDataSource{}
+)
+
+func NewSubscriberExportDataSource() datasource.DataSource {
+	return &SubscriberExportDataSource{}
+}
+
+// SubscriberExportDataSource defines the data source implementation.
+type SubscriberExportDataSource struct {
+	client *listmonk.Client
+}
+
+// SubscriberExportDataSourceModel describes the data source data model.
+type SubscriberExportDataSourceModel struct {
+	ID            types.Int64                                   `tfsdk:"id"`
+	Profile       *SubscriberExportDataSourceProfileModel       `tfsdk:"profile"`
+	Subscriptions []SubscriberExportDataSourceSubscriptionModel `tfsdk:"subscriptions"`
+	CampaignViews []SubscriberExportDataSourceCampaignViewModel `tfsdk:"campaign_views"`
+	LinkClicks    []SubscriberExportDataSourceLinkClickModel    `tfsdk:"link_clicks"`
+	JSON          types.String                                  `tfsdk:"json"`
+}
+
+// SubscriberExportDataSourceProfileModel describes the exported profile.
+type SubscriberExportDataSourceProfileModel struct {
+	UUID      types.String  `tfsdk:"uuid"`
+	Email     types.String  `tfsdk:"email"`
+	Name      types.String  `tfsdk:"name"`
+	Attribs   types.Dynamic `tfsdk:"attribs"`
+	Status    types.String  `tfsdk:"status"`
+	CreatedAt types.String  `tfsdk:"created_at"`
+	UpdatedAt types.String  `tfsdk:"updated_at"`
+}
+
+// SubscriberExportDataSourceSubscriptionModel describes an exported list subscription.
+type SubscriberExportDataSourceSubscriptionModel struct {
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	SubscriptionStatus types.String `tfsdk:"subscription_status"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+// SubscriberExportDataSourceCampaignViewModel describes an exported campaign view.
+type SubscriberExportDataSourceCampaignViewModel struct {
+	Subject   types.String `tfsdk:"subject"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// SubscriberExportDataSourceLinkClickModel describes an exported link click.
+type SubscriberExportDataSourceLinkClickModel struct {
+	URL       types.String `tfsdk:"url"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *SubscriberExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriber_export"
+}
+
+func (d *SubscriberExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Subscriber export data source. Returns the data listmonk holds about a subscriber, as exported for data-access requests. " +
+			"Sections disabled in listmonk's privacy settings are empty.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Subscriber identifier",
+				Required:            true,
+			},
+			"profile": schema.SingleNestedAttribute{
+				MarkdownDescription: "Subscriber profile",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"uuid": schema.StringAttribute{
+						MarkdownDescription: "Subscriber UUID",
+						Computed:            true,
+					},
+					"email": schema.StringAttribute{
+						MarkdownDescription: "E-mail address",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Subscriber name",
+						Computed:            true,
+					},
+					"attribs": schema.DynamicAttribute{
+						MarkdownDescription: "Subscriber attributes",
+						Computed:            true,
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Subscriber status",
+						Computed:            true,
+					},
+					"created_at": schema.StringAttribute{
+						MarkdownDescription: "Subscriber created at",
+						Computed:            true,
+					},
+					"updated_at": schema.StringAttribute{
+						MarkdownDescription: "Subscriber updated at",
+						Computed:            true,
+					},
+				},
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				MarkdownDescription: "List subscriptions",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "List name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "List type",
+							Computed:            true,
+						},
+						"subscription_status": schema.StringAttribute{
+							MarkdownDescription: "Subscription status",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Subscription created at",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"campaign_views": schema.ListNestedAttribute{
+				MarkdownDescription: "Campaign views",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "Campaign subject",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "View created at",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "View updated at",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"link_clicks": schema.ListNestedAttribute{
+				MarkdownDescription: "Link clicks",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Clicked URL",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Click created at",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Click updated at",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The export as the raw JSON document served by listmonk",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SubscriberExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *SubscriberExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubscriberExportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the export from the client.
+	export, raw, err := d.client.ExportSubscriber(int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export Subscriber, got error: %s", err))
+		return
+	}
+
+	// Set the data source state from the client response.
+	err = fromSubscriberExport(ctx, &data, export)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to convert subscriber attributes, got error: %s", err))
+		return
+	}
+	data.JSON = types.StringValue(string(raw))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromSubscriberExport sets the exported sections of the model.
+func fromSubscriberExport(ctx context.Context, data *SubscriberExportDataSourceModel, export *listmonk.SubscriberExport) error {
+	data.Profile = nil
+	if len(export.Profile) > 0 {
+		profile := export.Profile[0]
+		raw := string(profile.Attribs)
+		if raw == "" || raw == "null" {
+			raw = "{}"
+		}
+		attribs, err := jsonToDynamic(ctx, []byte(raw))
+		if err != nil {
+			return err
+		}
+		data.Profile = &SubscriberExportDataSourceProfileModel{
+			UUID:      types.StringValue(profile.UUID),
+			Email:     types.StringValue(profile.Email),
+			Name:      types.StringValue(profile.Name),
+			Attribs:   attribs,
+			Status:    types.StringValue(profile.Status),
+			CreatedAt: types.StringValue(profile.CreatedAt),
+			UpdatedAt: types.StringValue(profile.UpdatedAt),
+		}
+	}
+
+	data.Subscriptions = []SubscriberExportDataSourceSubscriptionModel{}
+	for _, subscription := range export.Subscriptions {
+		data.Subscriptions = append(data.Subscriptions, SubscriberExportDataSourceSubscriptionModel{
+			Name:               types.StringValue(subscription.Name),
+			Type:               types.StringValue(subscription.Type),
+			SubscriptionStatus: types.StringValue(subscription.SubscriptionStatus),
+			CreatedAt:          types.StringValue(subscription.CreatedAt),
+		})
+	}
+
+	data.CampaignViews = []SubscriberExportDataSourceCampaignViewModel{}
+	for _, view := range export.CampaignViews {
+		data.CampaignViews = append(data.CampaignViews, SubscriberExportDataSourceCampaignViewModel{
+			Subject:   types.StringValue(view.Subject),
+			CreatedAt: types.StringValue(view.CreatedAt),
+			UpdatedAt: types.StringValue(view.UpdatedAt),
+		})
+	}
+
+	data.LinkClicks = []SubscriberExportDataSourceLinkClickModel{}
+	for _, click := range export.LinkClicks {
+		data.LinkClicks = append(data.LinkClicks, SubscriberExportDataSourceLinkClickModel{
+			URL:       types.StringValue(click.URL),
+			CreatedAt: types.StringValue(click.CreatedAt),
+			UpdatedAt: types.StringValue(click.UpdatedAt),
+		})
+	}
+
+	return nil
+}
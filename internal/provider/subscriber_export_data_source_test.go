@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSubscriberExport(t *testing.T) {
+	var export listmonk.SubscriberExport
+	err := json.Unmarshal([]byte(`{
+		"profile": [{"uuid": "a1", "email": "john@example.com", "name": "John", "attribs": null, "status": "enabled"}],
+		"subscriptions": [{"name": "Opt-in list", "type": "public", "subscription_status": "confirmed"}],
+		"campaign_views": [{"subject": "Welcome"}],
+		"link_clicks": null
+	}`), &export)
+	assert.NoError(t, err)
+
+	var data SubscriberExportDataSourceModel
+	assert.NoError(t, fromSubscriberExport(context.Background(), &data, &export))
+
+	assert.Equal(t, "john@example.com", data.Profile.Email.ValueString())
+	assert.Equal(t, types.DynamicValue(types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})), data.Profile.Attribs)
+	assert.Len(t, data.Subscriptions, 1)
+	assert.Equal(t, "confirmed", data.Subscriptions[0].SubscriptionStatus.ValueString())
+	assert.Len(t, data.CampaignViews, 1)
+	assert.NotNil(t, data.LinkClicks)
+	assert.Empty(t, data.LinkClicks)
+
+	assert.NoError(t, fromSubscriberExport(context.Background(), &data, &listmonk.SubscriberExport{}))
+	assert.Nil(t, data.Profile)
+}
+
+func TestAccSubscriberExportDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "listmonk_subscriber_export" "example" {
+					id = 1
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_subscriber_export.example", "profile.email", "john@example.com"),
+					resource.TestCheckResourceAttrSet("data.listmonk_subscriber_export.example", "profile.uuid"),
+					resource.TestCheckResourceAttrSet("data.listmonk_subscriber_export.example", "subscriptions.#"),
+					resource.TestCheckResourceAttrSet("data.listmonk_subscriber_export.example", "json"),
+				),
+			},
+		},
+	})
+}
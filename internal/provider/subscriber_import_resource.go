@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"terraform-provider-listmonk/internal/listmonk"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &subscriberImportResource{}
+	_ resource.ResourceWithConfigure        = &subscriberImportResource{}
+	_ resource.ResourceWithModifyPlan       = &subscriberImportResource{}
+	_ resource.ResourceWithConfigValidators = &subscriberImportResource{}
+)
+
+// subscriberImportPollInterval is how often the import status is polled.
+const subscriberImportPollInterval = 2 * time.Second
+
+// subscriberImportContentFilename is the file name inline content is uploaded as.
+const subscriberImportContentFilename = "subscribers.csv"
+
+// NewSubscriberImportResource is a helper function to simplify the provider implementation.
+func NewSubscriberImportResource() resource.Resource {
+	return &subscriberImportResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subscriberImportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// subscriberImportResource is the resource implementation.
+type subscriberImportResource struct {
+	client *listmonk.Client
+}
+
+// subscriberImportResourceModel describes the resource data model.
+type subscriberImportResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	File          types.String `tfsdk:"file"`
+	Content       types.String `tfsdk:"content"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Mode          types.String `tfsdk:"mode"`
+	Overwrite     types.Bool   `tfsdk:"overwrite"`
+	Delimiter     types.String `tfsdk:"delimiter"`
+	ListIDs       types.Set    `tfsdk:"list_ids"`
+	Status        types.String `tfsdk:"status"`
+	Total         types.Int64  `tfsdk:"total"`
+	Imported      types.Int64  `tfsdk:"imported"`
+	Log           types.String `tfsdk:"log"`
+}
+
+// Metadata returns the resource type name.
+func (s *subscriberImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriber_import"
+}
+
+// Schema defines the schema for the resource.
+func (s *subscriberImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "One-shot import of subscribers from a CSV or ZIP file. The import runs on create and is run again " +
+			"whenever the content or the import settings change. Destroying the resource only removes it from the state",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Import identifier, the SHA-256 of the imported content",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path to a CSV file, or a ZIP file containing one, to import. " +
+					"Only the SHA-256 of the file is stored in the state. Exactly one of `file` or `content` must be set",
+				Optional: true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "CSV content to import. The content is stored in the state, marked as sensitive; " +
+					"use `file` to keep personal data out of the state. Exactly one of `file` or `content` must be set",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"content_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 of the imported content. The import is run again when it changes",
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Import mode: `subscribe` to add subscribers to `list_ids`, or `blocklist` to blocklist them. Defaults to `subscribe`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("subscribe"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("subscribe", "blocklist"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				MarkdownDescription: "Overwrite the name and attributes of existing subscribers. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"delimiter": schema.StringAttribute{
+				MarkdownDescription: "CSV field delimiter. Defaults to `,`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(","),
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"list_ids": schema.SetAttribute{
+				MarkdownDescription: "Lists to subscribe the imported subscribers to",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the import when it ended",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Number of records in the import",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"imported": schema.Int64Attribute{
+				MarkdownDescription: "Number of records imported",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"log": schema.StringAttribute{
+				MarkdownDescription: "Log of the import",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators checks that the content is set once.
+func (s *subscriberImportResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("file"),
+			path.MatchRoot("content"),
+		),
+	}
+}
+
+// ModifyPlan plans the hash of the content, reading it from file if set, and
+// replaces the import when it changes.
+func (s *subscriberImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan subscriberImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.File.IsUnknown() || plan.Content.IsUnknown() {
+		plan.ContentSHA256 = types.StringUnknown()
+	} else {
+		content, diags := plan.importContent()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.ContentSHA256 = types.StringValue(subscriberImportSHA256(content))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), plan.ContentSHA256)...)
+
+	// Nothing else to do on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state subscriberImportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ContentSHA256.Equal(state.ContentSHA256) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+	}
+}
+
+// Create runs the import and waits for it to end.
+func (s *subscriberImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subscriberImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, diags := plan.plannedImportContent()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := listmonk.ImportParams{
+		Mode:      plan.Mode.ValueString(),
+		Delimiter: plan.Delimiter.ValueString(),
+		Lists:     []int{},
+		Overwrite: plan.Overwrite.ValueBool(),
+	}
+	if !plan.ListIDs.IsNull() {
+		var listIDs []int64
+		resp.Diagnostics.Append(plan.ListIDs.ElementsAs(ctx, &listIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, id := range listIDs {
+			params.Lists = append(params.Lists, int(id))
+		}
+	}
+
+	filename := subscriberImportContentFilename
+	if !plan.File.IsNull() {
+		filename = filepath.Base(plan.File.ValueString())
+	}
+
+	ctx = tflog.SetField(ctx, "content_sha256", plan.ContentSHA256.ValueString())
+	tflog.Info(ctx, "Importing subscribers")
+
+	_, err := s.client.ImportSubscribers(&params, filename, content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import subscribers",
+			fmt.Sprintf("Failed to start the import: %s", err),
+		)
+		return
+	}
+
+	status, err := waitForImport(ctx, s.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import subscribers",
+			fmt.Sprintf("Failed to read the import status: %s", err),
+		)
+		return
+	}
+
+	log, err := s.client.GetImportLogs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to import subscribers",
+			fmt.Sprintf("Failed to read the import log: %s", err),
+		)
+		return
+	}
+
+	if status.Status != "finished" {
+		resp.Diagnostics.AddError(
+			"Failed to import subscribers",
+			fmt.Sprintf("The import ended with status %q after importing %d of %d records:\n%s", status.Status, status.Imported, status.Total, log),
+		)
+		return
+	}
+
+	plan.ID = plan.ContentSHA256
+	plan.Status = types.StringValue(status.Status)
+	plan.Total = types.Int64Value(int64(status.Total))
+	plan.Imported = types.Int64Value(int64(status.Imported))
+	plan.Log = types.StringValue(log)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// waitForImport polls the import status until the import is no longer running.
+func waitForImport(ctx context.Context, client *listmonk.Client) (*listmonk.ImportStatus, error) {
+	ticker := time.NewTicker(subscriberImportPollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := client.GetImportStatus()
+		if err != nil {
+			return nil, err
+		}
+		if !isImportRunning(status.Status) {
+			return status, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for subscriber import", map[string]interface{}{
+			"status":   status.Status,
+			"imported": status.Imported,
+			"total":    status.Total,
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Read keeps the state as is: the import is a one-shot operation and
+// listmonk only remembers the latest one.
+func (s *subscriberImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update only stores changes that don't change the imported content, such as
+// moving the file or inlining it.
+func (s *subscriberImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan subscriberImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags = plan.plannedImportContent()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the state. Imported subscribers are kept.
+func (s *subscriberImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// importContent returns the configured content, reading it from file if set.
+func (m subscriberImportResourceModel) importContent() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.File.IsNull() {
+		return []byte(m.Content.ValueString()), diags
+	}
+
+	content, err := os.ReadFile(m.File.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("file"),
+			"Unable to read file",
+			fmt.Sprintf("Unable to read file: %s", err),
+		)
+		return nil, diags
+	}
+
+	return content, diags
+}
+
+// plannedImportContent returns the content to import, making sure it still
+// matches the planned hash.
+func (m subscriberImportResourceModel) plannedImportContent() ([]byte, diag.Diagnostics) {
+	content, diags := m.importContent()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !m.ContentSHA256.IsUnknown() && m.ContentSHA256.ValueString() != subscriberImportSHA256(content) {
+		diags.AddAttributeError(
+			path.Root("file"),
+			"file changed",
+			"The content of file changed after the plan was created. Run terraform plan again.",
+		)
+	}
+
+	return content, diags
+}
+
+// subscriberImportSHA256 returns the hex encoded SHA-256 of the content.
+func subscriberImportSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// isImportRunning reports whether an import with the given status is still running.
+func isImportRunning(status string) bool {
+	return status == "importing" || status == "stopping"
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSubscriberImportResource(t *testing.T) {
+	config := func(content string) string {
+		return providerConfig + `
+				resource "listmonk_subscriber_import" "test" {
+					content  = <<-EOT
+` + content + `
+					EOT
+					list_ids = [2]
+				}
+`
+	}
+	header := "email,name,attributes\n"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber_import" "test" {
+					file    = "subscribers.csv"
+					content = "email,name"
+				}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Create and Read testing
+			{
+				Config: config(header +
+					"tf-test-import-1@example.com,Import One,{}\n" +
+					"tf-test-import-2@example.com,Import Two,{}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber_import.test", "status", "finished"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_import.test", "total", "2"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_import.test", "imported", "2"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_import.test", "mode", "subscribe"),
+					resource.TestCheckResourceAttrSet("listmonk_subscriber_import.test", "content_sha256"),
+					resource.TestCheckResourceAttrSet("listmonk_subscriber_import.test", "log"),
+				),
+			},
+			// Changed content runs the import again
+			{
+				Config: config(header +
+					"tf-test-import-1@example.com,Import One,{}\n" +
+					"tf-test-import-2@example.com,Import Two,{}\n" +
+					"tf-test-import-3@example.com,Import Three,{}"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_subscriber_import.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber_import.test", "total", "3"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &subscriberOptinResource{}
+	_ resource.ResourceWithConfigure = &subscriberOptinResource{}
+)
+
+// NewSubscriberOptinResource is a helper function to simplify the provider implementation.
+func NewSubscriberOptinResource() resource.Resource {
+	return &subscriberOptinResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subscriberOptinResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// subscriberOptinResource is the resource implementation.
+type subscriberOptinResource struct {
+	client *listmonk.Client
+}
+
+// subscriberOptinResourceModel describes the resource data model.
+type subscriberOptinResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	SubscriberID types.Int64  `tfsdk:"subscriber_id"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+}
+
+// Metadata returns the resource type name.
+func (s *subscriberOptinResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriber_optin"
+}
+
+// Schema defines the schema for the resource.
+func (s *subscriberOptinResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Sends a subscriber the opt-in e-mail for its unconfirmed subscriptions to double opt-in lists. " +
+			"The e-mail is sent on create and sent again whenever the subscriber or `triggers` change. " +
+			"Destroying the resource only removes it from the state",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Opt-in identifier, the subscriber identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_id": schema.Int64Attribute{
+				MarkdownDescription: "Subscriber identifier",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that send the e-mail again when they change, for example the subscriber's lists",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create sends the opt-in e-mail.
+func (s *subscriberOptinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subscriberOptinResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "subscriber_id", plan.SubscriberID.ValueInt64())
+	tflog.Info(ctx, "Sending opt-in e-mail")
+
+	err := s.client.SendSubscriberOptin(int(plan.SubscriberID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to send opt-in e-mail",
+			fmt.Sprintf("Failed to send opt-in e-mail: %s", err),
+		)
+		return
+	}
+
+	// Populate Computed attribute values
+	plan.ID = types.StringValue(strconv.FormatInt(plan.SubscriberID.ValueInt64(), 10))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read removes the resource when the subscriber is gone, so that a subscriber
+// created again gets the e-mail.
+func (s *subscriberOptinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subscriberOptinResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "subscriber_id", state.SubscriberID.ValueInt64())
+	tflog.Info(ctx, "Reading subscriber opt-in")
+
+	_, err := s.client.GetSubscriber(int(state.SubscriberID.ValueInt64()))
+	if listmonk.IsNotFound(err) {
+		tflog.Warn(ctx, "Subscriber not found, removing opt-in from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read subscriber opt-in",
+			fmt.Sprintf("Failed to read subscriber: %s", err),
+		)
+		return
+	}
+}
+
+// Update is never called: all attributes require replacement.
+func (s *subscriberOptinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete removes the resource from the state. A sent e-mail can't be undone.
+func (s *subscriberOptinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
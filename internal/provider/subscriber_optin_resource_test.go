@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSubscriberOptinResource(t *testing.T) {
+	config := func(run string) string {
+		return providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email            = "tf-test-optin@example.com"
+					lists            = [2]
+					send_optin_email = true
+				}
+
+				resource "listmonk_subscriber_optin" "test" {
+					subscriber_id = listmonk_subscriber.test.id
+					triggers      = { run = "` + run + `" }
+				}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email                    = "tf-test-optin@example.com"
+					lists                    = [2]
+					send_optin_email         = true
+					preconfirm_subscriptions = true
+				}
+`,
+				ExpectError: regexp.MustCompile(`Invalid opt-in configuration`),
+			},
+			// Create and Read testing
+			{
+				Config: config("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber.test", "send_optin_email", "true"),
+					resource.TestCheckResourceAttrPair("listmonk_subscriber_optin.test", "id", "listmonk_subscriber.test", "id"),
+				),
+			},
+			// Changed triggers send the e-mail again
+			{
+				Config: config("2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_subscriber_optin.test", plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction("listmonk_subscriber.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &subscriberQueryOperationResource{}
+	_ resource.ResourceWithConfigure      = &subscriberQueryOperationResource{}
+	_ resource.ResourceWithValidateConfig = &subscriberQueryOperationResource{}
+)
+
+// Values of operation. The list operations map to the actions of listmonk's
+// query lists endpoint.
+const (
+	subscriberQueryOperationDelete      = "delete"
+	subscriberQueryOperationBlocklist   = "blocklist"
+	subscriberQueryOperationAdd         = "add"
+	subscriberQueryOperationRemove      = "remove"
+	subscriberQueryOperationUnsubscribe = "unsubscribe"
+)
+
+// NewSubscriberQueryOperationResource is a helper function to simplify the provider implementation.
+func NewSubscriberQueryOperationResource() resource.Resource {
+	return &subscriberQueryOperationResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subscriberQueryOperationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// subscriberQueryOperationResource is the resource implementation.
+type subscriberQueryOperationResource struct {
+	client *listmonk.Client
+}
+
+// subscriberQueryOperationResourceModel describes the resource data model.
+type subscriberQueryOperationResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Operation           types.String `tfsdk:"operation"`
+	Query               types.String `tfsdk:"query"`
+	ListIDs             types.Set    `tfsdk:"list_ids"`
+	TargetListIDs       types.Set    `tfsdk:"target_list_ids"`
+	SubscriptionStatus  types.String `tfsdk:"subscription_status"`
+	ExpectedMaxAffected types.Int64  `tfsdk:"expected_max_affected"`
+	Triggers            types.Map    `tfsdk:"triggers"`
+	Affected            types.Int64  `tfsdk:"affected"`
+}
+
+// Metadata returns the resource type name.
+func (s *subscriberQueryOperationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriber_query_operation"
+}
+
+// Schema defines the schema for the resource.
+func (s *subscriberQueryOperationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Runs a bulk operation on the subscribers matching a query. The operation runs on create and " +
+			"runs again whenever the operation, the selection or `triggers` change. Destroying the resource only removes it from the state",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Operation identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				MarkdownDescription: "Operation to run: `delete` or `blocklist` the subscribers, or `add` them to, " +
+					"`remove` them from or `unsubscribe` them from `target_list_ids`",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						subscriberQueryOperationDelete,
+						subscriberQueryOperationBlocklist,
+						subscriberQueryOperationAdd,
+						subscriberQueryOperationRemove,
+						subscriberQueryOperationUnsubscribe,
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "listmonk SQL expression selecting the subscribers, for example `subscribers.status = 'blocklisted'`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"list_ids": schema.SetAttribute{
+				MarkdownDescription: "Only select subscribers of these lists",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_list_ids": schema.SetAttribute{
+				MarkdownDescription: "Lists to add the subscribers to, or remove or unsubscribe them from. " +
+					"Required by the `add`, `remove` and `unsubscribe` operations",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"subscription_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the subscriptions created by the `add` operation. One of `unconfirmed`, `confirmed` or `unsubscribed`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("unconfirmed", "confirmed", "unsubscribed"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expected_max_affected": schema.Int64Attribute{
+				MarkdownDescription: "Abort instead of running the operation if the query matches more subscribers than this",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that run the operation again when they change, for example a date to run a hygiene job daily",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"affected": schema.Int64Attribute{
+				MarkdownDescription: "Number of subscribers the query matched when the operation ran",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that target_list_ids and subscription_status are set
+// for the operations that use them.
+func (s *subscriberQueryOperationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config subscriberQueryOperationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Operation.IsUnknown() || config.TargetListIDs.IsUnknown() {
+		return
+	}
+
+	operation := config.Operation.ValueString()
+	listOperation := operation == subscriberQueryOperationAdd || operation == subscriberQueryOperationRemove || operation == subscriberQueryOperationUnsubscribe
+	if listOperation && (config.TargetListIDs.IsNull() || len(config.TargetListIDs.Elements()) == 0) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_list_ids"),
+			"Missing target_list_ids",
+			fmt.Sprintf("The %q operation requires target_list_ids.", operation),
+		)
+	}
+	if !listOperation && !config.TargetListIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_list_ids"),
+			"Unexpected target_list_ids",
+			fmt.Sprintf("target_list_ids can't be set for the %q operation.", operation),
+		)
+	}
+	if operation != subscriberQueryOperationAdd && !config.SubscriptionStatus.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subscription_status"),
+			"Unexpected subscription_status",
+			fmt.Sprintf("subscription_status can only be set for the %q operation.", subscriberQueryOperationAdd),
+		)
+	}
+}
+
+// Create runs the operation if the query doesn't match too many subscribers.
+func (s *subscriberQueryOperationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subscriberQueryOperationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := plan.toSubscriberQueryRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	operation := plan.Operation.ValueString()
+	ctx = tflog.SetField(ctx, "operation", operation)
+	ctx = tflog.SetField(ctx, "query", request.Query)
+
+	affected, err := countSubscribers(s.client, request.Query, request.ListIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to run subscriber query operation",
+			fmt.Sprintf("Failed to count matching subscribers: %s", err),
+		)
+		return
+	}
+	if int64(affected) > plan.ExpectedMaxAffected.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_max_affected"),
+			"Too many subscribers affected",
+			fmt.Sprintf("The query matches %d subscribers, more than expected_max_affected (%d). The %s operation was not run.",
+				affected, plan.ExpectedMaxAffected.ValueInt64(), operation),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Running subscriber query operation", map[string]interface{}{"affected": affected})
+	if affected > 0 {
+		switch operation {
+		case subscriberQueryOperationDelete:
+			err = s.client.DeleteSubscribersByQuery(request)
+		case subscriberQueryOperationBlocklist:
+			err = s.client.BlocklistSubscribersByQuery(request)
+		default:
+			err = s.client.UpdateSubscriberListsByQuery(request)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to run subscriber query operation",
+				fmt.Sprintf("Failed to %s subscribers: %s", operation, err),
+			)
+			return
+		}
+	}
+
+	// Populate Computed attribute values
+	plan.ID = types.StringValue(subscriberSelectionID(operation+": "+request.Query, request.ListIDs))
+	plan.Affected = types.Int64Value(int64(affected))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the state as is: the operation is a one-shot operation.
+func (s *subscriberQueryOperationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update only stores a changed expected_max_affected, which doesn't run the
+// operation again.
+func (s *subscriberQueryOperationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan subscriberQueryOperationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the state. The operation is not undone.
+func (s *subscriberQueryOperationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// toSubscriberQueryRequest converts the model to a listmonk request body.
+func (m subscriberQueryOperationResourceModel) toSubscriberQueryRequest(ctx context.Context) (*listmonk.SubscriberQueryRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	listIDs, d := int64SetToInts(ctx, m.ListIDs)
+	diags.Append(d...)
+	targetListIDs, d := int64SetToInts(ctx, m.TargetListIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	request := &listmonk.SubscriberQueryRequest{
+		Query:   m.Query.ValueString(),
+		ListIDs: listIDs,
+	}
+	switch operation := m.Operation.ValueString(); operation {
+	case subscriberQueryOperationAdd, subscriberQueryOperationRemove, subscriberQueryOperationUnsubscribe:
+		request.Action = operation
+		request.TargetListIDs = targetListIDs
+		request.Status = m.SubscriptionStatus.ValueString()
+	}
+
+	return request, diags
+}
+
+// int64SetToInts converts a set of numbers to sorted ints. A null set is empty.
+func int64SetToInts(ctx context.Context, set types.Set) ([]int, diag.Diagnostics) {
+	ints := []int{}
+	if set.IsNull() || set.IsUnknown() {
+		return ints, nil
+	}
+
+	var values []int64
+	diags := set.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, value := range values {
+		ints = append(ints, int(value))
+	}
+	sort.Ints(ints)
+	return ints, diags
+}
+
+// countSubscribers returns the number of subscribers matching a query in
+// the given lists, or in all lists if there are none.
+func countSubscribers(client *listmonk.Client, query string, listIDs []int) (int, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	for _, listID := range listIDs {
+		params.Add("list_id", strconv.Itoa(listID))
+	}
+
+	_, total, err := client.GetSubscribersLimit(params, 1)
+	return total, err
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriberQueryOperationRequest(t *testing.T) {
+	ctx := context.Background()
+	model := subscriberQueryOperationResourceModel{
+		Operation:          types.StringValue("add"),
+		Query:              types.StringValue("subscribers.name = 'x'"),
+		ListIDs:            types.SetNull(types.Int64Type),
+		TargetListIDs:      types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3), types.Int64Value(1)}),
+		SubscriptionStatus: types.StringValue("confirmed"),
+	}
+
+	request, diags := model.toSubscriberQueryRequest(ctx)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, &listmonk.SubscriberQueryRequest{
+		Query:         "subscribers.name = 'x'",
+		ListIDs:       []int{},
+		Action:        "add",
+		TargetListIDs: []int{1, 3},
+		Status:        "confirmed",
+	}, request)
+
+	model.Operation = types.StringValue("delete")
+	model.TargetListIDs = types.SetNull(types.Int64Type)
+	model.SubscriptionStatus = types.StringNull()
+	request, diags = model.toSubscriberQueryRequest(ctx)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, &listmonk.SubscriberQueryRequest{
+		Query:   "subscribers.name = 'x'",
+		ListIDs: []int{},
+	}, request)
+}
+
+func TestAccSubscriberQueryOperationResource(t *testing.T) {
+	config := func(expectedMaxAffected, run string) string {
+		return providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					count = 2
+					email = "tf-test-query-operation-${count.index}@example.com"
+				}
+
+				resource "listmonk_subscriber_query_operation" "test" {
+					operation             = "add"
+					query                 = "subscribers.email LIKE 'tf-test-query-operation-%'"
+					target_list_ids       = [2]
+					subscription_status   = "confirmed"
+					expected_max_affected = ` + expectedMaxAffected + `
+					triggers              = { run = "` + run + `" }
+
+					depends_on = [listmonk_subscriber.test]
+				}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber_query_operation" "test" {
+					operation             = "remove"
+					query                 = "subscribers.id = 1"
+					expected_max_affected = 1
+				}
+`,
+				ExpectError: regexp.MustCompile(`Missing target_list_ids`),
+			},
+			// Guard testing
+			{
+				Config:      config("1", "1"),
+				ExpectError: regexp.MustCompile(`Too many subscribers affected`),
+			},
+			// Create testing
+			{
+				Config: config("2", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber_query_operation.test", "affected", "2"),
+					resource.TestCheckResourceAttrSet("listmonk_subscriber_query_operation.test", "id"),
+				),
+			},
+			// Raising the guard doesn't run the operation again
+			{
+				Config: config("10", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_subscriber_query_operation.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// Changed triggers run it again
+			{
+				Config: config("10", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_subscriber_query_operation.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,729 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &subscriberResource{}
+	_ resource.ResourceWithConfigure      = &subscriberResource{}
+	_ resource.ResourceWithImportState    = &subscriberResource{}
+	_ resource.ResourceWithValidateConfig = &subscriberResource{}
+)
+
+// subscriberImportEmailPrefix prefixes import IDs that are e-mail addresses.
+const subscriberImportEmailPrefix = "email:"
+
+// Values of on_destroy.
+const (
+	subscriberOnDestroyDelete         = "delete"
+	subscriberOnDestroyBlocklist      = "blocklist"
+	subscriberOnDestroyUnsubscribeAll = "unsubscribe_all"
+)
+
+// NewSubscriberResource is a helper function to simplify the provider implementation.
+func NewSubscriberResource() resource.Resource {
+	return &subscriberResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subscriberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// subscriberResource is the resource implementation.
+type subscriberResource struct {
+	client       *listmonk.Client
+	providerData *ListmonkProviderData
+}
+
+// subscriberResourceModel describes the resource data model.
+type subscriberResourceModel struct {
+	ID        types.Int64   `tfsdk:"id"`
+	UUID      types.String  `tfsdk:"uuid"`
+	CreatedAt types.String  `tfsdk:"created_at"`
+	UpdatedAt types.String  `tfsdk:"updated_at"`
+	Email     types.String  `tfsdk:"email"`
+	Name      types.String  `tfsdk:"name"`
+	Status    types.String  `tfsdk:"status"`
+	Attribs   types.Dynamic `tfsdk:"attribs"`
+	Lists     types.Set     `tfsdk:"lists"`
+	// ManagedAttribsKeys limits attribs to these keys if set.
+	ManagedAttribsKeys types.Set `tfsdk:"managed_attribs_keys"`
+	// PreconfirmSubscriptions is only used when subscriptions are added.
+	PreconfirmSubscriptions types.Bool `tfsdk:"preconfirm_subscriptions"`
+	// SendOptinEmail is only used on creation.
+	SendOptinEmail types.Bool `tfsdk:"send_optin_email"`
+	// OnDestroy is only used on deletion.
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
+// Metadata returns the resource type name.
+func (s *subscriberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriber"
+}
+
+// Schema defines the schema for the resource.
+func (s *subscriberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Subscriber. E-mail addresses are unique in listmonk",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Subscriber identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "Subscriber UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Subscriber created at",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Subscriber updated at",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "E-mail address. Differences in case are ignored",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Subscriber name. Defaults to the name listmonk derives from the e-mail address",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Subscriber status. One of `enabled` or `blocklisted`. Blocklisting unsubscribes the subscriber from all lists. Defaults to `enabled`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("enabled"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("enabled", "blocklisted"),
+				},
+			},
+			"attribs": schema.DynamicAttribute{
+				MarkdownDescription: "Subscriber attributes as an object of arbitrary values, e.g. `{ city = \"Bengaluru\" }`. " +
+					"Differences in key order and number formatting are ignored",
+				Optional: true,
+			},
+			"managed_attribs_keys": schema.SetAttribute{
+				MarkdownDescription: "Only manage these keys of `attribs`. Other keys are left to other systems: they are kept on update and do not show up in `attribs`. " +
+					"Managed keys missing from `attribs` are removed. Leave unset to manage all attributes",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"lists": schema.SetAttribute{
+				MarkdownDescription: "IDs of the lists the subscriber is subscribed to. Subscriptions to lists missing from this set are removed. " +
+					"Leave unset to manage subscriptions elsewhere. Imported subscribers have no lists in the state, " +
+					"so the first plan after import shows the configured lists being added",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"preconfirm_subscriptions": schema.BoolAttribute{
+				MarkdownDescription: "Confirm new subscriptions to double opt-in lists without sending an opt-in e-mail",
+				Optional:            true,
+			},
+			"send_optin_email": schema.BoolAttribute{
+				MarkdownDescription: "Send the opt-in e-mail for subscriptions to double opt-in lists when the subscriber is created, " +
+					"like the public subscription form does. listmonk already sends it when the `app.send_optin_confirmation` setting is enabled, " +
+					"in which case it isn't sent again. Can't be combined with `preconfirm_subscriptions`. " +
+					"Use `listmonk_subscriber_optin` to send it again later",
+				Optional: true,
+			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What happens to the subscriber when the resource is destroyed: `delete` deletes it, " +
+					"`blocklist` keeps it blocklisted and unsubscribed from all lists so that it can't be added again, " +
+					"and `unsubscribe_all` keeps it unsubscribed from all lists. Defaults to `delete`. " +
+					"Must be applied before the subscriber is destroyed",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(subscriberOnDestroyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(subscriberOnDestroyDelete, subscriberOnDestroyBlocklist, subscriberOnDestroyUnsubscribeAll),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that send_optin_email isn't combined with
+// preconfirm_subscriptions, and that attribs is an object whose keys are all
+// in managed_attribs_keys when it is set.
+func (s *subscriberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config subscriberResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SendOptinEmail.ValueBool() && config.PreconfirmSubscriptions.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("send_optin_email"),
+			"Invalid opt-in configuration",
+			"send_optin_email can't be combined with preconfirm_subscriptions, which confirms subscriptions without an opt-in e-mail.",
+		)
+	}
+
+	if config.Attribs.IsNull() || config.Attribs.IsUnknown() || config.Attribs.IsUnderlyingValueUnknown() {
+		return
+	}
+	var keys []string
+	switch attribs := config.Attribs.UnderlyingValue().(type) {
+	case basetypes.ObjectValue:
+		for key := range attribs.Attributes() {
+			keys = append(keys, key)
+		}
+	case basetypes.MapValue:
+		for key := range attribs.Elements() {
+			keys = append(keys, key)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attribs"),
+			"Invalid subscriber attributes",
+			fmt.Sprintf("attribs must be an object, got %s.", config.Attribs.UnderlyingValue().Type(ctx)),
+		)
+		return
+	}
+
+	// Keys outside managed_attribs_keys would be dropped silently
+	if config.ManagedAttribsKeys.IsUnknown() {
+		return
+	}
+	managedKeys, diags := managedAttribsKeys(ctx, config.ManagedAttribsKeys)
+	resp.Diagnostics.Append(diags...)
+	if managedKeys == nil {
+		return
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !managedKeys[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attribs"),
+				"Unmanaged subscriber attribute",
+				fmt.Sprintf("Attribute %q is not in managed_attribs_keys. Add it there to manage it.", key),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (s *subscriberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subscriberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	subscriber, diags := plan.toSubscriberRequest(ctx, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new subscriber
+	r, err := s.client.CreateSubscriber(subscriber)
+	if listmonk.IsConflict(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Subscriber already exists",
+			fmt.Sprintf("A subscriber with the e-mail address %q already exists in listmonk. "+
+				"Import it with `terraform import <address> %s%s` to manage it with this resource.",
+				subscriber.Email, subscriberImportEmailPrefix, subscriber.Email),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create subscriber",
+			fmt.Sprintf("Failed to create subscriber: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(plan.fromSubscriber(ctx, r)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The subscriber exists at this point, so a failure to send the opt-in
+	// e-mail must not taint it. listmonk sends it on creation itself when
+	// app.send_optin_confirmation is enabled, so it would be sent twice.
+	if plan.SendOptinEmail.ValueBool() {
+		sent, err := optinSentOnCreate(s.client)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("send_optin_email"),
+				"Failed to send opt-in e-mail",
+				fmt.Sprintf("The subscriber was created, but the opt-in e-mail was not sent because the settings could not be read: %s. "+
+					"Send it with listmonk_subscriber_optin or from the listmonk UI.", err),
+			)
+			return
+		}
+		if sent {
+			tflog.Info(ctx, "Opt-in e-mail sent by listmonk on creation", map[string]interface{}{"id": r.ID})
+			return
+		}
+		tflog.Info(ctx, "Sending opt-in e-mail", map[string]interface{}{"id": r.ID})
+		err = s.client.SendSubscriberOptin(r.ID)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("send_optin_email"),
+				"Failed to send opt-in e-mail",
+				fmt.Sprintf("The subscriber was created, but the opt-in e-mail could not be sent: %s. "+
+					"Send it with listmonk_subscriber_optin or from the listmonk UI.", err),
+			)
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (s *subscriberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subscriberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "id", state.ID)
+	tflog.Info(ctx, "Reading subscriber")
+
+	// Get refreshed subscriber value from Listmonk
+	subscriber, err := s.client.GetSubscriber(int(state.ID.ValueInt64()))
+	if listmonk.IsNotFound(err) {
+		tflog.Warn(ctx, "Subscriber not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read subscriber",
+			fmt.Sprintf("Failed to read subscriber: %s", err),
+		)
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(state.fromSubscriber(ctx, subscriber)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (s *subscriberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan subscriberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// listmonk replaces all subscriptions and attributes on update, so the
+	// current ones are needed to keep those not managed by this resource.
+	current, err := s.client.GetSubscriber(int(plan.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update subscriber",
+			fmt.Sprintf("Failed to read subscriber: %s", err),
+		)
+		return
+	}
+
+	// Generate API request body from plan
+	subscriber, diags := plan.toSubscriberRequest(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing subscriber
+	r, err := s.client.UpdateSubscriber(int(plan.ID.ValueInt64()), subscriber)
+	if listmonk.IsConflict(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Subscriber already exists",
+			fmt.Sprintf("Another subscriber with the e-mail address %q already exists in listmonk.", subscriber.Email),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update subscriber",
+			fmt.Sprintf("Failed to update subscriber: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(plan.fromSubscriber(ctx, r)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (s *subscriberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state subscriberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriberID := int(state.ID.ValueInt64())
+	ctx = tflog.SetField(ctx, "on_destroy", state.OnDestroy.ValueString())
+
+	// Delete existing subscriber, or keep it as configured
+	var err error
+	switch state.OnDestroy.ValueString() {
+	case subscriberOnDestroyBlocklist:
+		tflog.Info(ctx, "Blocklisting subscriber instead of deleting it")
+		err = s.client.BlocklistSubscribers([]int{subscriberID})
+	case subscriberOnDestroyUnsubscribeAll:
+		tflog.Info(ctx, "Unsubscribing subscriber from all lists instead of deleting it")
+		err = s.unsubscribeAll(subscriberID)
+	default:
+		err = s.client.DeleteSubscriber(subscriberID)
+	}
+	if err != nil && !listmonk.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to delete subscriber",
+			fmt.Sprintf("Failed to delete subscriber: %s", err),
+		)
+		return
+	}
+}
+
+// unsubscribeAll unsubscribes a subscriber from all its lists.
+func (s *subscriberResource) unsubscribeAll(subscriberID int) error {
+	subscriber, err := s.client.GetSubscriber(subscriberID)
+	if err != nil {
+		return err
+	}
+
+	listIDs := []int{}
+	for _, list := range subscriber.Lists {
+		if list.SubscriptionStatus != "unsubscribed" {
+			listIDs = append(listIDs, list.ID)
+		}
+	}
+	if len(listIDs) == 0 {
+		return nil
+	}
+
+	return s.client.UpdateSubscriberLists(&listmonk.SubscriberListsRequest{
+		IDs:           []int{subscriberID},
+		Action:        "unsubscribe",
+		TargetListIDs: listIDs,
+	})
+}
+
+// ImportState imports a subscriber by its ID or by `email:<address>`.
+func (s *subscriberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var subscriberId int64
+	if email, ok := strings.CutPrefix(req.ID, subscriberImportEmailPrefix); ok {
+		subscriber, err := s.client.GetSubscriberByEmail(email)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to import subscriber",
+				fmt.Sprintf("Failed to look up subscriber: %s", err),
+			)
+			return
+		}
+		if subscriber == nil {
+			resp.Diagnostics.AddError(
+				"Unable to import subscriber",
+				fmt.Sprintf("No subscriber with the e-mail address %q exists.", email),
+			)
+			return
+		}
+		subscriberId = int64(subscriber.ID)
+	} else {
+		var err error
+		subscriberId, err = strconv.ParseInt(req.ID, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to parse subscriber ID (importing)",
+				fmt.Sprintf("Import ID must be a subscriber ID or %s<address>: %s", subscriberImportEmailPrefix, err),
+			)
+			return
+		}
+	}
+
+	// lists is left null, as whether it is managed is only known from the
+	// configuration; the next apply sets it if it is.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), subscriberId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_destroy"), subscriberOnDestroyDelete)...)
+}
+
+// toSubscriberRequest converts the model to a listmonk request body. Lists
+// and attributes not managed by the model are taken from the current
+// subscriber, which is nil on create.
+func (m *subscriberResourceModel) toSubscriberRequest(ctx context.Context, current *listmonk.Subscriber) (*listmonk.SubscriberRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	lists := []int{}
+	if !m.Lists.IsNull() {
+		diags.Append(m.Lists.ElementsAs(ctx, &lists, false)...)
+	} else if current != nil {
+		for _, list := range current.Lists {
+			lists = append(lists, list.ID)
+		}
+	}
+
+	managedKeys, d := managedAttribsKeys(ctx, m.ManagedAttribsKeys)
+	diags.Append(d...)
+	var currentAttribs json.RawMessage
+	if current != nil {
+		currentAttribs = current.Attribs
+	}
+	attribs, err := mergeAttribs(m.Attribs, currentAttribs, managedKeys)
+	if err != nil {
+		diags.AddAttributeError(path.Root("attribs"), "Invalid subscriber attributes", err.Error())
+	}
+
+	return &listmonk.SubscriberRequest{
+		Email:                   m.Email.ValueString(),
+		Name:                    m.Name.ValueString(),
+		Status:                  m.Status.ValueString(),
+		Lists:                   lists,
+		Attribs:                 attribs,
+		PreconfirmSubscriptions: m.PreconfirmSubscriptions.ValueBool(),
+	}, diags
+}
+
+// fromSubscriber sets the model's attributes from a listmonk subscriber.
+// Unmanaged lists and empty attributes are left null, and the e-mail address
+// is kept if it only differs in case.
+func (m *subscriberResourceModel) fromSubscriber(ctx context.Context, subscriber *listmonk.Subscriber) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.Int64Value(int64(subscriber.ID))
+	m.UUID = types.StringValue(subscriber.UUID)
+	m.CreatedAt = types.StringValue(subscriber.CreatedAt)
+	m.UpdatedAt = types.StringValue(subscriber.UpdatedAt)
+	if !strings.EqualFold(m.Email.ValueString(), subscriber.Email) {
+		m.Email = types.StringValue(subscriber.Email)
+	}
+	m.Name = types.StringValue(subscriber.Name)
+	m.Status = types.StringValue(subscriber.Status)
+
+	managedKeys, d := managedAttribsKeys(ctx, m.ManagedAttribsKeys)
+	diags.Append(d...)
+	attribs, d := attribsFromJSON(ctx, m.Attribs, subscriber.Attribs, managedKeys)
+	diags.Append(d...)
+	m.Attribs = attribs
+
+	if !m.Lists.IsNull() {
+		lists := make([]int64, 0, len(subscriber.Lists))
+		for _, list := range subscriber.Lists {
+			lists = append(lists, int64(list.ID))
+		}
+		var d diag.Diagnostics
+		m.Lists, d = types.SetValueFrom(ctx, types.Int64Type, lists)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// attribsToJSON encodes subscriber attributes, which must be an object.
+func attribsToJSON(attribs types.Dynamic) (json.RawMessage, error) {
+	value, err := dynamicToGo(attribs)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := value.(map[string]interface{}); !ok && value != nil {
+		return nil, fmt.Errorf("attribs must be an object, got %T", value)
+	}
+
+	return json.Marshal(value)
+}
+
+// managedAttribsKeys returns the set of managed attribute keys, or nil if all
+// keys are managed.
+func managedAttribsKeys(ctx context.Context, keys types.Set) (map[string]bool, diag.Diagnostics) {
+	if keys.IsNull() || keys.IsUnknown() {
+		return nil, nil
+	}
+
+	var elements []string
+	diags := keys.ElementsAs(ctx, &elements, false)
+	managed := make(map[string]bool, len(elements))
+	for _, key := range elements {
+		managed[key] = true
+	}
+
+	return managed, diags
+}
+
+// mergeAttribs returns the attributes to send to listmonk: the configured
+// attributes, plus the current attributes outside the managed keys if only
+// some keys are managed.
+func mergeAttribs(attribs types.Dynamic, current json.RawMessage, managedKeys map[string]bool) (json.RawMessage, error) {
+	configured := map[string]json.RawMessage{}
+	if !attribs.IsNull() {
+		attribsJSON, err := attribsToJSON(attribs)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(attribsJSON, &configured); err != nil {
+			return nil, err
+		}
+	}
+	if managedKeys == nil {
+		return json.Marshal(configured)
+	}
+
+	merged := map[string]json.RawMessage{}
+	if len(current) > 0 {
+		if err := json.Unmarshal(current, &merged); err != nil {
+			return nil, fmt.Errorf("unable to decode current attributes: %w", err)
+		}
+	}
+	for key := range managedKeys {
+		delete(merged, key)
+	}
+	for key, value := range configured {
+		merged[key] = value
+	}
+
+	return json.Marshal(merged)
+}
+
+// attribsFromJSON converts subscriber attributes returned by listmonk,
+// limited to the managed keys unless those are nil. The prior value is kept
+// if it encodes to the same JSON, so that key order, number formatting and
+// the HCL types used do not show up as changes. Empty attributes are null if
+// the prior value is.
+func attribsFromJSON(ctx context.Context, prior types.Dynamic, attribs json.RawMessage, managedKeys map[string]bool) (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(attribs) == 0 {
+		attribs = json.RawMessage("{}")
+	}
+	if managedKeys != nil {
+		all := map[string]json.RawMessage{}
+		if err := json.Unmarshal(attribs, &all); err != nil {
+			diags.AddError(
+				"Invalid subscriber attributes",
+				fmt.Sprintf("Unable to decode subscriber attributes returned by listmonk: %s", err),
+			)
+			return prior, diags
+		}
+		managed := map[string]json.RawMessage{}
+		for key, value := range all {
+			if managedKeys[key] {
+				managed[key] = value
+			}
+		}
+		attribs, _ = json.Marshal(managed)
+	}
+	if prior.IsNull() && jsonEqual(string(attribs), "{}") {
+		return prior, diags
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorJSON, err := attribsToJSON(prior)
+		if err == nil && jsonEqual(string(priorJSON), string(attribs)) {
+			return prior, diags
+		}
+	}
+
+	value, err := jsonToDynamic(ctx, attribs)
+	if err != nil {
+		diags.AddError(
+			"Invalid subscriber attributes",
+			fmt.Sprintf("Unable to convert subscriber attributes returned by listmonk: %s", err),
+		)
+	}
+
+	return value, diags
+}
+
+// optinSentOnCreate reports whether listmonk sends the opt-in e-mail itself
+// when a subscriber is created, as set by app.send_optin_confirmation.
+func optinSentOnCreate(client *listmonk.Client) (bool, error) {
+	settings, err := client.GetSettings()
+	if err != nil {
+		return false, err
+	}
+	sent, _ := settings["app.send_optin_confirmation"].(bool)
+	return sent, nil
+}
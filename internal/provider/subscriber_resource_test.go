@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccSubscriberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email  = "tf-test@example.com"
+					status = "disabled"
+				}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email   = "tf-test@example.com"
+					name    = "Terraform Test"
+					attribs = { city = "Bengaluru", projects = 3, tags = ["a", "b"] }
+					lists   = [1]
+
+					preconfirm_subscriptions = true
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber.test", "email", "tf-test@example.com"),
+					resource.TestCheckResourceAttr("listmonk_subscriber.test", "name", "Terraform Test"),
+					resource.TestCheckResourceAttr("listmonk_subscriber.test", "status", "enabled"),
+					resource.TestCheckResourceAttr("listmonk_subscriber.test", "lists.#", "1"),
+					resource.TestCheckResourceAttrSet("listmonk_subscriber.test", "id"),
+					resource.TestCheckResourceAttrSet("listmonk_subscriber.test", "uuid"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "listmonk_subscriber.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at", "preconfirm_subscriptions", "lists"},
+			},
+			// ImportState by e-mail testing
+			{
+				ResourceName:            "listmonk_subscriber.test",
+				ImportState:             true,
+				ImportStateId:           "email:tf-test@example.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at", "preconfirm_subscriptions", "lists"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email   = "tf-test@example.com"
+					name    = "Terraform Test"
+					status  = "blocklisted"
+					attribs = { projects = 3.0, city = "Bengaluru", tags = ["a", "b"] }
+					lists   = [1, 2]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber.test", "status", "blocklisted"),
+					resource.TestCheckResourceAttr("listmonk_subscriber.test", "lists.#", "2"),
+				),
+			},
+			// Attribute validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email   = "tf-test@example.com"
+					attribs = ["city"]
+				}
+`,
+				ExpectError: regexp.MustCompile(`attribs must be an object`),
+			},
+			// Unique e-mail testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email   = "tf-test@example.com"
+					name    = "Terraform Test"
+					status  = "blocklisted"
+					attribs = { projects = 3.0, city = "Bengaluru", tags = ["a", "b"] }
+					lists   = [1, 2]
+				}
+
+				resource "listmonk_subscriber" "duplicate" {
+					email = "TF-Test@example.com"
+				}
+`,
+				ExpectError: regexp.MustCompile(`Subscriber already exists`),
+			},
+		},
+	})
+}
+
+func TestAttribsFromJSON(t *testing.T) {
+	ctx := context.Background()
+	prior := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"city": types.StringType, "projects": types.NumberType},
+		map[string]attr.Value{"city": types.StringValue("Bengaluru"), "projects": types.NumberValue(big.NewFloat(3))},
+	))
+
+	t.Run("equal JSON keeps the prior value", func(t *testing.T) {
+		attribs, diags := attribsFromJSON(ctx, prior, []byte(`{"projects": 3.0, "city": "Bengaluru"}`), nil)
+		require.False(t, diags.HasError())
+		assert.True(t, attribs.Equal(prior))
+	})
+
+	t.Run("changed JSON is converted", func(t *testing.T) {
+		attribs, diags := attribsFromJSON(ctx, prior, []byte(`{"city": "Chennai", "tags": ["a"], "extra": null}`), nil)
+		require.False(t, diags.HasError())
+		value, err := dynamicToGo(attribs)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"city": "Chennai", "tags": []interface{}{"a"}, "extra": nil}, value)
+	})
+
+	t.Run("unmanaged keys are ignored", func(t *testing.T) {
+		attribs, diags := attribsFromJSON(ctx, prior, []byte(`{"projects": 3, "city": "Bengaluru", "last_login": "2026-10-19"}`), map[string]bool{"city": true, "projects": true})
+		require.False(t, diags.HasError())
+		assert.True(t, attribs.Equal(prior))
+	})
+
+	t.Run("empty attributes stay null", func(t *testing.T) {
+		attribs, diags := attribsFromJSON(ctx, types.DynamicNull(), []byte(`{}`), nil)
+		require.False(t, diags.HasError())
+		assert.True(t, attribs.IsNull())
+	})
+}
+
+func TestMergeAttribs(t *testing.T) {
+	configured := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"department": types.StringType},
+		map[string]attr.Value{"department": types.StringValue("engineering")},
+	))
+	current := []byte(`{"department": "sales", "locale": "en", "plan": "pro"}`)
+
+	t.Run("all keys managed", func(t *testing.T) {
+		merged, err := mergeAttribs(configured, current, nil)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"department": "engineering"}`, string(merged))
+	})
+
+	t.Run("unmanaged keys are kept", func(t *testing.T) {
+		merged, err := mergeAttribs(configured, current, map[string]bool{"department": true, "locale": true})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"department": "engineering", "plan": "pro"}`, string(merged))
+	})
+
+	t.Run("null attributes on create", func(t *testing.T) {
+		merged, err := mergeAttribs(types.DynamicNull(), nil, map[string]bool{"department": true})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(merged))
+	})
+}
+
+func TestAccSubscriberResourceManagedAttribsKeys(t *testing.T) {
+	config := providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email   = "tf-test-managed@example.com"
+					attribs = { department = "engineering" }
+
+					managed_attribs_keys = ["department", "locale"]
+				}
+`
+
+	var subscriberID int
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email   = "tf-test-managed@example.com"
+					attribs = { department = "engineering", plan = "pro" }
+
+					managed_attribs_keys = ["department", "locale"]
+				}
+`,
+				ExpectError: regexp.MustCompile(`Unmanaged subscriber attribute`),
+			},
+			{
+				Config: config,
+				Check: resource.TestCheckResourceAttrWith("listmonk_subscriber.test", "id", func(value string) error {
+					var err error
+					subscriberID, err = strconv.Atoi(value)
+					return err
+				}),
+			},
+			// Attributes written by other systems are neither drift nor removed
+			{
+				PreConfig: func() {
+					client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+					_, err := client.UpdateSubscriber(subscriberID, &listmonk.SubscriberRequest{
+						Email:   "tf-test-managed@example.com",
+						Name:    "tf-test-managed",
+						Status:  "enabled",
+						Lists:   []int{},
+						Attribs: json.RawMessage(`{"department": "engineering", "locale": "en", "plan": "pro"}`),
+					})
+					require.NoError(t, err)
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_subscriber.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+					subscriber, err := client.GetSubscriber(subscriberID)
+					if err != nil {
+						return err
+					}
+					if !jsonEqual(string(subscriber.Attribs), `{"department": "engineering", "plan": "pro"}`) {
+						return fmt.Errorf("unexpected attribs %s", subscriber.Attribs)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccSubscriberResourceOnDestroy(t *testing.T) {
+	var subscriberID int
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber" "test" {
+					email      = "tf-test-on-destroy@example.com"
+					lists      = [2]
+					on_destroy = "blocklist"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber.test", "on_destroy", "blocklist"),
+					resource.TestCheckResourceAttrWith("listmonk_subscriber.test", "id", func(value string) error {
+						var err error
+						subscriberID, err = strconv.Atoi(value)
+						return err
+					}),
+				),
+			},
+			// Destroying keeps the subscriber blocklisted
+			{
+				Config: providerConfig,
+				Check: func(s *terraform.State) error {
+					client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+					subscriber, err := client.GetSubscriber(subscriberID)
+					if err != nil {
+						return err
+					}
+					if subscriber.Status != "blocklisted" {
+						return fmt.Errorf("unexpected status %s", subscriber.Status)
+					}
+					for _, list := range subscriber.Lists {
+						if list.SubscriptionStatus != "unsubscribed" {
+							return fmt.Errorf("unexpected subscription status %s for list %d", list.SubscriptionStatus, list.ID)
+						}
+					}
+					return client.DeleteSubscriber(subscriberID)
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,970 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &subscriberSetResource{}
+	_ resource.ResourceWithConfigure      = &subscriberSetResource{}
+	_ resource.ResourceWithModifyPlan     = &subscriberSetResource{}
+	_ resource.ResourceWithValidateConfig = &subscriberSetResource{}
+)
+
+// subscriberSetBatchSize is the number of subscribers changed per bulk
+// subscription request.
+const subscriberSetBatchSize = 1000
+
+// subscriberSetChangesAttrTypes are the attribute types of the changes object.
+var subscriberSetChangesAttrTypes = map[string]attr.Type{
+	"subscribe":   types.Int64Type,
+	"update":      types.Int64Type,
+	"unsubscribe": types.Int64Type,
+}
+
+// NewSubscriberSetResource is a helper function to simplify the provider implementation.
+func NewSubscriberSetResource() resource.Resource {
+	return &subscriberSetResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subscriberSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// subscriberSetResource is the resource implementation.
+type subscriberSetResource struct {
+	client *listmonk.Client
+}
+
+// subscriberSetResourceModel describes the resource data model.
+type subscriberSetResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	ListID                  types.Int64  `tfsdk:"list_id"`
+	Subscribers             types.Set    `tfsdk:"subscribers"`
+	SubscribersFile         types.String `tfsdk:"subscribers_file"`
+	PreconfirmSubscriptions types.Bool   `tfsdk:"preconfirm_subscriptions"`
+	MembersSHA256           types.String `tfsdk:"members_sha256"`
+	Changes                 types.Object `tfsdk:"changes"`
+}
+
+// subscriberSetResourceMemberModel describes a subscriber of the set.
+type subscriberSetResourceMemberModel struct {
+	Email   types.String    `tfsdk:"email"`
+	Name    types.String    `tfsdk:"name"`
+	Attribs jsonStringValue `tfsdk:"attribs"`
+}
+
+// subscriberSetMember is a subscriber of the set with a normalised e-mail
+// address and canonical attributes. Nil fields are not managed.
+type subscriberSetMember struct {
+	Email   string
+	Name    *string
+	Attribs json.RawMessage
+}
+
+// subscriberSetDelta describes the changes needed to reconcile a list with
+// the set.
+type subscriberSetDelta struct {
+	// Subscribe are members without an active subscription to the list.
+	Subscribe []subscriberSetMember
+	// Update are subscribers whose managed fields differ from their member.
+	Update map[int]subscriberSetMember
+	// Unsubscribe are active subscribers of the list that are not members.
+	Unsubscribe []int
+	// Existing are the list's subscribers by e-mail address, including
+	// unsubscribed ones.
+	Existing map[string]*listmonk.Subscriber
+}
+
+// Metadata returns the resource type name.
+func (s *subscriberSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscriber_set"
+}
+
+// Schema defines the schema for the resource.
+func (s *subscriberSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authoritative set of the subscribers of a list. Missing subscribers are created or subscribed, changed ones updated " +
+			"and subscribers of the list that are not in the set are unsubscribed. A hash of the list's subscribers is kept in the state " +
+			"to detect changes made outside of Terraform, and the plan reports how many subscribers will change. " +
+			"With `subscribers_file` only that hash is stored; inline `subscribers` are also stored in full. " +
+			"Subscribers that are not in the list are added with a single subscriber import, so no other import may run at the same time",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the set, the list identifier",
+				Computed:            true,
+			},
+			"list_id": schema.Int64Attribute{
+				MarkdownDescription: "List identifier",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"subscribers": schema.SetNestedAttribute{
+				MarkdownDescription: "Subscribers of the list. E-mail addresses must be unique, ignoring case. " +
+					"Every subscriber is stored in the state and shown in plans; use `subscribers_file` for large lists. " +
+					"Exactly one of `subscribers` or `subscribers_file` must be set",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "E-mail address",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Subscriber name. Left unchanged if unset; new subscribers are named after their e-mail address",
+							Optional:            true,
+						},
+						"attribs": schema.StringAttribute{
+							MarkdownDescription: "JSON object of subscriber attributes, for example from `jsonencode`. It is a JSON string, " +
+								"as Terraform providers can't accept dynamic values inside sets. Left unchanged if unset",
+							Optional:   true,
+							CustomType: jsonStringType{},
+						},
+					},
+				},
+			},
+			"subscribers_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a CSV file with the subscribers of the list, in listmonk's import format: " +
+					"an `email` column and optional `name` and `attributes` columns, the latter holding JSON objects. " +
+					"Empty cells leave the field unchanged. Only the path and `members_sha256` are stored in the state, " +
+					"which keeps state and plans small for large lists. The file is read again to refresh and destroy the set, so keep it in place. " +
+					"Exactly one of `subscribers` or `subscribers_file` must be set",
+				Optional: true,
+			},
+			"preconfirm_subscriptions": schema.BoolAttribute{
+				MarkdownDescription: "Confirm new subscriptions without sending opt-in e-mails. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"members_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 of the managed fields of the list's subscribers",
+				Computed:            true,
+			},
+			"changes": schema.SingleNestedAttribute{
+				MarkdownDescription: "Number of subscribers changed by the latest apply. Planned when the list differs from the set",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"subscribe": schema.Int64Attribute{
+						MarkdownDescription: "Subscribers created or subscribed to the list",
+						Computed:            true,
+					},
+					"update": schema.Int64Attribute{
+						MarkdownDescription: "Subscribers whose name or attributes are updated",
+						Computed:            true,
+					},
+					"unsubscribe": schema.Int64Attribute{
+						MarkdownDescription: "Subscribers unsubscribed from the list",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the subscribers are set once, that e-mail
+// addresses are unique and that attribs are objects.
+func (s *subscriberSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config subscriberSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Subscribers.IsUnknown() && !config.SubscribersFile.IsUnknown() && config.Subscribers.IsNull() == config.SubscribersFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subscribers"),
+			"Invalid subscribers configuration",
+			"Exactly one of subscribers or subscribers_file must be set.",
+		)
+		return
+	}
+
+	_, diags := config.members(ctx)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan plans the hash of the set and counts the subscribers that will
+// change.
+func (s *subscriberSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state subscriberSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := plan.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || members == nil {
+		// Unknown subscribers
+		return
+	}
+	membersSHA256 := subscriberSetMembersSHA256(members, nil, 0)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("members_sha256"), membersSHA256)...)
+
+	// Keep the latest changes if the list is in sync with the set
+	if !req.State.Raw.IsNull() && state.MembersSHA256.ValueString() == membersSHA256 && state.ListID.Equal(plan.ListID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("changes"), state.Changes)...)
+		return
+	}
+	if plan.ListID.IsUnknown() || s.client == nil {
+		return
+	}
+
+	// Count the changes against the list's current subscribers. A list
+	// that does not exist yet has none.
+	remote, err := s.client.GetSubscribers(url.Values{"list_id": {strconv.FormatInt(plan.ListID.ValueInt64(), 10)}})
+	if err != nil && !listmonk.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to plan subscriber set",
+			fmt.Sprintf("Failed to read the list's subscribers: %s", err),
+		)
+		return
+	}
+	delta := subscriberSetDiff(members, remote, int(plan.ListID.ValueInt64()))
+	changes, diags := delta.changes()
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("changes"), changes)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (s *subscriberSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subscriberSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the list with the set
+	resp.Diagnostics.Append(s.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (s *subscriberSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subscriberSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "list_id", state.ListID)
+	tflog.Info(ctx, "Reading subscriber set")
+
+	members, diags := state.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || members == nil {
+		return
+	}
+
+	// Get the list's subscribers from Listmonk
+	listID := int(state.ListID.ValueInt64())
+	_, err := s.client.GetList(listID)
+	if listmonk.IsNotFound(err) {
+		tflog.Warn(ctx, "List not found, removing subscriber set from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read subscriber set",
+			fmt.Sprintf("Failed to read list: %s", err),
+		)
+		return
+	}
+	remote, err := s.client.GetSubscribers(url.Values{"list_id": {strconv.Itoa(listID)}})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read subscriber set",
+			fmt.Sprintf("Failed to read the list's subscribers: %s", err),
+		)
+		return
+	}
+
+	// Overwrite the hash with the one of the list's subscribers
+	state.MembersSHA256 = types.StringValue(subscriberSetMembersSHA256(members, remote, listID))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (s *subscriberSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan subscriberSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the list with the set
+	resp.Diagnostics.Append(s.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (s *subscriberSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state subscriberSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := state.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unsubscribe the members of the set. The subscribers themselves are kept.
+	listID := int(state.ListID.ValueInt64())
+	remote, err := s.client.GetSubscribers(url.Values{"list_id": {strconv.Itoa(listID)}})
+	if listmonk.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete subscriber set",
+			fmt.Sprintf("Failed to read the list's subscribers: %s", err),
+		)
+		return
+	}
+	ids := []int{}
+	for i := range remote {
+		if _, ok := members[normalizeEmail(remote[i].Email)]; ok && isActiveSubscription(&remote[i], listID) {
+			ids = append(ids, remote[i].ID)
+		}
+	}
+	err = s.updateSubscriptions(ids, listID, "unsubscribe", "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete subscriber set",
+			fmt.Sprintf("Failed to unsubscribe subscribers: %s", err),
+		)
+	}
+}
+
+// reconcile applies the changes needed to make the list's subscribers match
+// the planned set.
+func (s *subscriberSetResource) reconcile(ctx context.Context, plan *subscriberSetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members, d := plan.members(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if !plan.MembersSHA256.IsUnknown() && plan.MembersSHA256.ValueString() != subscriberSetMembersSHA256(members, nil, 0) {
+		diags.AddAttributeError(
+			path.Root("subscribers_file"),
+			"subscribers_file changed",
+			"The content of subscribers_file changed after the plan was created. Run terraform plan again.",
+		)
+		return diags
+	}
+
+	listID := int(plan.ListID.ValueInt64())
+	remote, err := s.client.GetSubscribers(url.Values{"list_id": {strconv.Itoa(listID)}})
+	if err != nil {
+		diags.AddError(
+			"Failed to reconcile subscriber set",
+			fmt.Sprintf("Failed to read the list's subscribers: %s", err),
+		)
+		return diags
+	}
+	delta := subscriberSetDiff(members, remote, listID)
+	tflog.Info(ctx, "Reconciling subscriber set", map[string]interface{}{
+		"subscribe":   len(delta.Subscribe),
+		"update":      len(delta.Update),
+		"unsubscribe": len(delta.Unsubscribe),
+	})
+
+	status := "unconfirmed"
+	if plan.PreconfirmSubscriptions.ValueBool() {
+		status = "confirmed"
+	}
+
+	// Update the fields of existing subscribers first, as updates replace
+	// the subscriber's lists with the current ones.
+	for id, member := range delta.Update {
+		existing := delta.Existing[member.Email]
+		_, err := s.client.UpdateSubscriber(id, member.updateRequest(existing))
+		if err != nil {
+			diags.AddError(
+				"Failed to reconcile subscriber set",
+				fmt.Sprintf("Failed to update subscriber %q: %s", member.Email, err),
+			)
+			return diags
+		}
+	}
+
+	// Subscribe members that are subscribers of the list, and import the
+	// others in bulk
+	subscribe := []int{}
+	create := []subscriberSetMember{}
+	for _, member := range delta.Subscribe {
+		if existing, ok := delta.Existing[member.Email]; ok {
+			subscribe = append(subscribe, existing.ID)
+			continue
+		}
+		create = append(create, member)
+	}
+	err = s.updateSubscriptions(subscribe, listID, "add", status)
+	if err != nil {
+		diags.AddError(
+			"Failed to reconcile subscriber set",
+			fmt.Sprintf("Failed to subscribe subscribers: %s", err),
+		)
+		return diags
+	}
+	if len(create) > 0 {
+		diags.Append(s.importMembers(ctx, create, listID, status)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Unsubscribe subscribers that are not in the set
+	err = s.updateSubscriptions(delta.Unsubscribe, listID, "unsubscribe", "")
+	if err != nil {
+		diags.AddError(
+			"Failed to reconcile subscriber set",
+			fmt.Sprintf("Failed to unsubscribe subscribers: %s", err),
+		)
+		return diags
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(listID))
+	plan.MembersSHA256 = types.StringValue(subscriberSetMembersSHA256(members, nil, 0))
+	if plan.Changes.IsUnknown() {
+		changes, d := delta.changes()
+		diags.Append(d...)
+		plan.Changes = changes
+	}
+
+	return diags
+}
+
+// importMembers creates members that are not subscribers of the list with a
+// single subscriber import. The import replaces both the name and the
+// attributes of subscribers that already exist, so it only overwrites them if
+// every member manages both; managed fields it left unchanged are then
+// updated one subscriber at a time.
+func (s *subscriberSetResource) importMembers(ctx context.Context, members []subscriberSetMember, listID int, status string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	content, err := subscriberSetImportCSV(members)
+	if err != nil {
+		diags.AddError(
+			"Failed to reconcile subscriber set",
+			fmt.Sprintf("Failed to build the subscriber import: %s", err),
+		)
+		return diags
+	}
+	params := &listmonk.ImportParams{
+		Mode:               "subscribe",
+		SubscriptionStatus: status,
+		Delimiter:          ",",
+		Lists:              []int{listID},
+		Overwrite:          true,
+	}
+	for _, member := range members {
+		if member.Name == nil || member.Attribs == nil {
+			params.Overwrite = false
+		}
+	}
+
+	tflog.Info(ctx, "Importing subscribers", map[string]interface{}{"count": len(members), "overwrite": params.Overwrite})
+	_, err = s.client.ImportSubscribers(params, subscriberImportContentFilename, content)
+	if err != nil {
+		diags.AddError(
+			"Failed to reconcile subscriber set",
+			fmt.Sprintf("Failed to start the subscriber import: %s", err),
+		)
+		return diags
+	}
+	importStatus, err := waitForImport(ctx, s.client)
+	if err != nil {
+		diags.AddError(
+			"Failed to reconcile subscriber set",
+			fmt.Sprintf("Failed to read the import status: %s", err),
+		)
+		return diags
+	}
+	if importStatus.Status != "finished" {
+		log, _ := s.client.GetImportLogs()
+		diags.AddError(
+			"Failed to reconcile subscriber set",
+			fmt.Sprintf("The subscriber import ended with status %q after importing %d of %d records:\n%s",
+				importStatus.Status, importStatus.Imported, importStatus.Total, log),
+		)
+		return diags
+	}
+
+	// Subscribers that existed before the import may still differ
+	remote, err := s.client.GetSubscribers(url.Values{"list_id": {strconv.Itoa(listID)}})
+	if err != nil {
+		diags.AddError(
+			"Failed to reconcile subscriber set",
+			fmt.Sprintf("Failed to read the list's subscribers: %s", err),
+		)
+		return diags
+	}
+	imported := map[string]*listmonk.Subscriber{}
+	for i := range remote {
+		imported[normalizeEmail(remote[i].Email)] = &remote[i]
+	}
+	for _, member := range members {
+		existing, ok := imported[member.Email]
+		if !ok {
+			log, _ := s.client.GetImportLogs()
+			diags.AddError(
+				"Failed to reconcile subscriber set",
+				fmt.Sprintf("Subscriber %q was not imported:\n%s", member.Email, log),
+			)
+			return diags
+		}
+		if !member.differsFrom(existing) {
+			continue
+		}
+		_, err := s.client.UpdateSubscriber(existing.ID, member.updateRequest(existing))
+		if err != nil {
+			diags.AddError(
+				"Failed to reconcile subscriber set",
+				fmt.Sprintf("Failed to update subscriber %q: %s", member.Email, err),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// updateSubscriptions changes the subscriptions of the subscribers to the
+// list in batches.
+func (s *subscriberSetResource) updateSubscriptions(ids []int, listID int, action, status string) error {
+	for start := 0; start < len(ids); start += subscriberSetBatchSize {
+		end := start + subscriberSetBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		err := s.client.UpdateSubscriberLists(&listmonk.SubscriberListsRequest{
+			IDs:           ids[start:end],
+			Action:        action,
+			TargetListIDs: []int{listID},
+			Status:        status,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// members returns the members of the set, reading them from
+// subscribers_file if set. It returns nil if they are unknown.
+func (m subscriberSetResourceModel) members(ctx context.Context) (map[string]subscriberSetMember, diag.Diagnostics) {
+	if m.SubscribersFile.IsNull() {
+		return subscriberSetMembers(ctx, m.Subscribers)
+	}
+	if m.SubscribersFile.IsUnknown() {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+	content, err := os.ReadFile(m.SubscribersFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("subscribers_file"),
+			"Unable to read subscribers_file",
+			fmt.Sprintf("Unable to read subscribers_file: %s", err),
+		)
+		return nil, diags
+	}
+	subscribers, err := subscriberSetFileMembers(content)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("subscribers_file"),
+			"Invalid subscribers_file",
+			fmt.Sprintf("Unable to parse subscribers_file: %s", err),
+		)
+		return nil, diags
+	}
+
+	return newSubscriberSetMembers(subscribers, path.Root("subscribers_file"))
+}
+
+// subscriberSetMembers converts the configured subscribers to members by
+// normalised e-mail address. It returns nil if any subscriber is unknown.
+func subscriberSetMembers(ctx context.Context, set types.Set) (map[string]subscriberSetMember, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+	var subscribers []subscriberSetResourceMemberModel
+	diags := set.ElementsAs(ctx, &subscribers, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, subscriber := range subscribers {
+		if subscriber.Email.IsUnknown() || subscriber.Name.IsUnknown() || subscriber.Attribs.IsUnknown() {
+			return nil, diags
+		}
+	}
+
+	members, d := newSubscriberSetMembers(subscribers, path.Root("subscribers"))
+	diags.Append(d...)
+	return members, diags
+}
+
+// subscriberSetFileMembers parses a CSV file of subscribers with an email
+// column and optional name and attributes columns. Empty cells are null.
+func subscriberSetFileMembers(content []byte) ([]subscriberSetResourceMemberModel, error) {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing header row")
+	}
+
+	columns := map[string]int{}
+	for i, column := range records[0] {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch column {
+		case "email", "name", "attributes":
+			columns[column] = i
+		default:
+			return nil, fmt.Errorf("unexpected column %q, expected email, name and attributes", column)
+		}
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, fmt.Errorf("missing email column")
+	}
+
+	cell := func(record []string, column string) types.String {
+		i, ok := columns[column]
+		if !ok || strings.TrimSpace(record[i]) == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(record[i])
+	}
+	subscribers := make([]subscriberSetResourceMemberModel, 0, len(records)-1)
+	for _, record := range records[1:] {
+		subscribers = append(subscribers, subscriberSetResourceMemberModel{
+			Email:   types.StringValue(record[columns["email"]]),
+			Name:    cell(record, "name"),
+			Attribs: jsonStringValue{StringValue: cell(record, "attributes")},
+		})
+	}
+
+	return subscribers, nil
+}
+
+// newSubscriberSetMembers converts subscribers to members by normalised
+// e-mail address, reporting errors on the attribute at p.
+func newSubscriberSetMembers(subscribers []subscriberSetResourceMemberModel, p path.Path) (map[string]subscriberSetMember, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	members := make(map[string]subscriberSetMember, len(subscribers))
+	for _, subscriber := range subscribers {
+		member := subscriberSetMember{
+			Email: normalizeEmail(subscriber.Email.ValueString()),
+			Name:  subscriber.Name.ValueStringPointer(),
+		}
+		if _, ok := members[member.Email]; ok {
+			diags.AddAttributeError(
+				p,
+				"Duplicate subscriber",
+				fmt.Sprintf("The e-mail address %q is in the set more than once.", member.Email),
+			)
+			continue
+		}
+		if !subscriber.Attribs.IsNull() {
+			attribs, err := canonicalAttribs([]byte(subscriber.Attribs.ValueString()))
+			if err != nil {
+				diags.AddAttributeError(
+					p,
+					"Invalid subscriber attributes",
+					fmt.Sprintf("Attributes of %q must be a JSON object: %s", member.Email, err),
+				)
+				continue
+			}
+			member.Attribs = attribs
+		}
+		members[member.Email] = member
+	}
+
+	return members, diags
+}
+
+// subscriberSetDiff compares the members with the list's subscribers.
+func subscriberSetDiff(members map[string]subscriberSetMember, remote []listmonk.Subscriber, listID int) subscriberSetDelta {
+	delta := subscriberSetDelta{
+		Update:   map[int]subscriberSetMember{},
+		Existing: map[string]*listmonk.Subscriber{},
+	}
+
+	for i := range remote {
+		subscriber := &remote[i]
+		email := normalizeEmail(subscriber.Email)
+		delta.Existing[email] = subscriber
+
+		member, ok := members[email]
+		if !ok {
+			if isActiveSubscription(subscriber, listID) {
+				delta.Unsubscribe = append(delta.Unsubscribe, subscriber.ID)
+			}
+			continue
+		}
+		if member.differsFrom(subscriber) {
+			delta.Update[subscriber.ID] = member
+		}
+	}
+
+	for email, member := range members {
+		if existing, ok := delta.Existing[email]; ok && isActiveSubscription(existing, listID) {
+			continue
+		}
+		delta.Subscribe = append(delta.Subscribe, member)
+	}
+	sort.Slice(delta.Subscribe, func(i, j int) bool { return delta.Subscribe[i].Email < delta.Subscribe[j].Email })
+	sort.Ints(delta.Unsubscribe)
+
+	return delta
+}
+
+// subscriberSetMembersSHA256 hashes the managed fields of the list's active
+// subscribers. Without remote subscribers, it hashes the members themselves,
+// which is the hash of a list that matches the set.
+func subscriberSetMembersSHA256(members map[string]subscriberSetMember, remote []listmonk.Subscriber, listID int) string {
+	entries := []string{}
+	if remote == nil {
+		for _, member := range members {
+			entries = append(entries, member.entry(member.Name, member.Attribs))
+		}
+	}
+	for i := range remote {
+		subscriber := &remote[i]
+		if !isActiveSubscription(subscriber, listID) {
+			continue
+		}
+
+		member, ok := members[normalizeEmail(subscriber.Email)]
+		if !ok {
+			// Unmanaged subscribers always change the hash
+			entries = append(entries, "unmanaged\x00"+normalizeEmail(subscriber.Email))
+			continue
+		}
+		var name *string
+		if member.Name != nil {
+			name = &subscriber.Name
+		}
+		var attribs json.RawMessage
+		if member.Attribs != nil {
+			attribs, _ = canonicalAttribs(subscriber.Attribs)
+		}
+		entries = append(entries, member.entry(name, attribs))
+	}
+	sort.Strings(entries)
+
+	sum := sha256.Sum256([]byte(strings.Join(entries, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// changes returns the number of changes as the value of the changes attribute.
+func (d subscriberSetDelta) changes() (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(subscriberSetChangesAttrTypes, map[string]attr.Value{
+		"subscribe":   types.Int64Value(int64(len(d.Subscribe))),
+		"update":      types.Int64Value(int64(len(d.Update))),
+		"unsubscribe": types.Int64Value(int64(len(d.Unsubscribe))),
+	})
+}
+
+// entry returns the hashed representation of a member with the given fields.
+func (m subscriberSetMember) entry(name *string, attribs json.RawMessage) string {
+	entry := m.Email
+	if m.Name != nil && name != nil {
+		entry += "\x00name:" + *name
+	}
+	if m.Attribs != nil {
+		entry += "\x00attribs:" + string(attribs)
+	}
+
+	return entry
+}
+
+// differsFrom reports whether the managed fields of the member differ from
+// the subscriber's.
+func (m subscriberSetMember) differsFrom(subscriber *listmonk.Subscriber) bool {
+	if m.Name != nil && *m.Name != subscriber.Name {
+		return true
+	}
+	if m.Attribs != nil {
+		attribs, err := canonicalAttribs(subscriber.Attribs)
+		if err != nil || string(attribs) != string(m.Attribs) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subscriberSetImportCSV returns the members as a CSV subscriber import.
+// Members without a name are named after their e-mail address, as imports
+// require one.
+func subscriberSetImportCSV(members []subscriberSetMember) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"email", "name", "attributes"}); err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		name := strings.SplitN(member.Email, "@", 2)[0]
+		if member.Name != nil {
+			name = *member.Name
+		}
+		attribs := member.Attribs
+		if attribs == nil {
+			attribs = json.RawMessage("{}")
+		}
+		if err := w.Write([]string{member.Email, name, string(attribs)}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
+
+// updateRequest returns the request updating the managed fields of an
+// existing subscriber, keeping its other fields and lists.
+func (m subscriberSetMember) updateRequest(existing *listmonk.Subscriber) *listmonk.SubscriberRequest {
+	request := &listmonk.SubscriberRequest{
+		Email:   existing.Email,
+		Name:    existing.Name,
+		Status:  existing.Status,
+		Lists:   []int{},
+		Attribs: existing.Attribs,
+	}
+	for _, list := range existing.Lists {
+		request.Lists = append(request.Lists, list.ID)
+	}
+	if m.Name != nil {
+		request.Name = *m.Name
+	}
+	if m.Attribs != nil {
+		request.Attribs = m.Attribs
+	}
+
+	return request
+}
+
+// isActiveSubscription reports whether the subscriber is subscribed to the
+// list and has not unsubscribed.
+func isActiveSubscription(subscriber *listmonk.Subscriber, listID int) bool {
+	for _, list := range subscriber.Lists {
+		if list.ID == listID {
+			return list.SubscriptionStatus != "unsubscribed"
+		}
+	}
+
+	return false
+}
+
+// canonicalAttribs re-encodes a JSON object with sorted keys and without
+// insignificant whitespace.
+func canonicalAttribs(attribs []byte) (json.RawMessage, error) {
+	if len(attribs) == 0 {
+		return json.RawMessage("{}"), nil
+	}
+
+	var value map[string]interface{}
+	if err := json.Unmarshal(attribs, &value); err != nil {
+		return nil, err
+	}
+	if value == nil {
+		value = map[string]interface{}{}
+	}
+
+	return json.Marshal(value)
+}
+
+// normalizeEmail lowercases an e-mail address, as listmonk compares e-mail
+// addresses case-insensitively.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
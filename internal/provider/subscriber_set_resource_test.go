@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriberSetDiff(t *testing.T) {
+	name := "Jane"
+	members := map[string]subscriberSetMember{
+		"jane@example.com":  {Email: "jane@example.com", Name: &name, Attribs: json.RawMessage(`{"team":"growth"}`)},
+		"john@example.com":  {Email: "john@example.com"},
+		"alice@example.com": {Email: "alice@example.com"},
+	}
+	remote := []listmonk.Subscriber{
+		{
+			ID: 1, Email: "Jane@example.com", Name: "Jane Doe", Attribs: json.RawMessage(`{"team": "growth"}`),
+			Lists: []listmonk.SubscriberList{{ID: 7, SubscriptionStatus: "confirmed"}},
+		},
+		{
+			ID: 2, Email: "john@example.com", Name: "John",
+			Lists: []listmonk.SubscriberList{{ID: 7, SubscriptionStatus: "unsubscribed"}},
+		},
+		{
+			ID: 3, Email: "bob@example.com", Name: "Bob",
+			Lists: []listmonk.SubscriberList{{ID: 7, SubscriptionStatus: "unconfirmed"}},
+		},
+	}
+
+	delta := subscriberSetDiff(members, remote, 7)
+	assert.Equal(t, []subscriberSetMember{members["alice@example.com"], members["john@example.com"]}, delta.Subscribe)
+	assert.Equal(t, map[int]subscriberSetMember{1: members["jane@example.com"]}, delta.Update)
+	assert.Equal(t, []int{3}, delta.Unsubscribe)
+
+	// The hash of the list only matches the set once it is reconciled
+	assert.NotEqual(t, subscriberSetMembersSHA256(members, nil, 0), subscriberSetMembersSHA256(members, remote, 7))
+
+	reconciled := []listmonk.Subscriber{
+		{
+			ID: 1, Email: "jane@example.com", Name: "Jane", Attribs: json.RawMessage(`{"team": "growth"}`),
+			Lists: []listmonk.SubscriberList{{ID: 7, SubscriptionStatus: "confirmed"}},
+		},
+		{
+			ID: 2, Email: "john@example.com", Name: "John",
+			Lists: []listmonk.SubscriberList{{ID: 7, SubscriptionStatus: "unconfirmed"}},
+		},
+		{
+			ID: 3, Email: "bob@example.com", Name: "Bob",
+			Lists: []listmonk.SubscriberList{{ID: 7, SubscriptionStatus: "unsubscribed"}},
+		},
+		{
+			ID: 4, Email: "alice@example.com", Name: "alice", Attribs: json.RawMessage(`{"unmanaged": true}`),
+			Lists: []listmonk.SubscriberList{{ID: 7, SubscriptionStatus: "unconfirmed"}},
+		},
+	}
+	assert.Equal(t, subscriberSetMembersSHA256(members, nil, 0), subscriberSetMembersSHA256(members, reconciled, 7))
+}
+
+func TestSubscriberSetImportCSV(t *testing.T) {
+	name := "Doe, Jane"
+	content, err := subscriberSetImportCSV([]subscriberSetMember{
+		{Email: "jane@example.com", Name: &name, Attribs: json.RawMessage(`{"team":"growth"}`)},
+		{Email: "john@example.com"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "email,name,attributes\n"+
+		"jane@example.com,\"Doe, Jane\",\"{\"\"team\"\":\"\"growth\"\"}\"\n"+
+		"john@example.com,john,{}\n", string(content))
+}
+
+func TestSubscriberSetFileMembers(t *testing.T) {
+	subscribers, err := subscriberSetFileMembers([]byte("Email,name,attributes\n" +
+		"Jane@example.com,\"Doe, Jane\",\"{\"\"team\"\": \"\"growth\"\"}\"\n" +
+		"john@example.com,,\n"))
+	require.NoError(t, err)
+	members, diags := newSubscriberSetMembers(subscribers, path.Root("subscribers_file"))
+	require.False(t, diags.HasError())
+
+	name := "Doe, Jane"
+	assert.Equal(t, map[string]subscriberSetMember{
+		"jane@example.com": {Email: "jane@example.com", Name: &name, Attribs: json.RawMessage(`{"team":"growth"}`)},
+		"john@example.com": {Email: "john@example.com"},
+	}, members)
+
+	// The name and attributes columns are optional
+	subscribers, err = subscriberSetFileMembers([]byte("email\njohn@example.com\n"))
+	require.NoError(t, err)
+	members, diags = newSubscriberSetMembers(subscribers, path.Root("subscribers_file"))
+	require.False(t, diags.HasError())
+	assert.Equal(t, map[string]subscriberSetMember{"john@example.com": {Email: "john@example.com"}}, members)
+
+	_, err = subscriberSetFileMembers([]byte("name\nJohn\n"))
+	assert.ErrorContains(t, err, "missing email column")
+	_, err = subscriberSetFileMembers([]byte("email,team\njohn@example.com,growth\n"))
+	assert.ErrorContains(t, err, `unexpected column "team"`)
+
+	subscribers, err = subscriberSetFileMembers([]byte("email,attributes\njohn@example.com,[]\n"))
+	require.NoError(t, err)
+	_, diags = newSubscriberSetMembers(subscribers, path.Root("subscribers_file"))
+	assert.True(t, diags.HasError())
+}
+
+func TestAccSubscriberSetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_subscriber_set" "test" {
+					list_id = 1
+					subscribers = [
+						{ email = "tf-test-set-1@example.com" },
+						{ email = "TF-Test-Set-1@example.com", name = "Duplicate" },
+					]
+				}
+`,
+				ExpectError: regexp.MustCompile(`Duplicate subscriber`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-subscriber-set"
+					type = "private"
+
+					deletion_protection           = false
+					allow_delete_with_subscribers = true
+				}
+
+				resource "listmonk_subscriber_set" "test" {
+					list_id = listmonk_list.test.id
+					subscribers = [
+						{ email = "tf-test-set-1@example.com", name = "One", attribs = jsonencode({ team = "growth" }) },
+						{ email = "tf-test-set-2@example.com" },
+					]
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber_set.test", "changes.subscribe", "2"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_set.test", "changes.unsubscribe", "0"),
+					resource.TestCheckResourceAttrSet("listmonk_subscriber_set.test", "members_sha256"),
+				),
+			},
+			// Update testing: one subscriber changes, one is replaced
+			{
+				Config: providerConfig + `
+				resource "listmonk_list" "test" {
+					name = "tf-test-subscriber-set"
+					type = "private"
+
+					deletion_protection           = false
+					allow_delete_with_subscribers = true
+				}
+
+				resource "listmonk_subscriber_set" "test" {
+					list_id = listmonk_list.test.id
+					subscribers = [
+						{ email = "tf-test-set-1@example.com", name = "One", attribs = jsonencode({ team = "sales" }) },
+						{ email = "tf-test-set-3@example.com" },
+					]
+				}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_subscriber_set.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber_set.test", "changes.subscribe", "1"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_set.test", "changes.update", "1"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_set.test", "changes.unsubscribe", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSubscriberSetResourceFile(t *testing.T) {
+	subscribersFile := filepath.Join(t.TempDir(), "subscribers.csv")
+	config := providerConfig + fmt.Sprintf(`
+				resource "listmonk_list" "test" {
+					name = "tf-test-subscriber-set-file"
+					type = "private"
+
+					deletion_protection           = false
+					allow_delete_with_subscribers = true
+				}
+
+				resource "listmonk_subscriber_set" "test" {
+					list_id          = listmonk_list.test.id
+					subscribers_file = %q
+				}
+`, subscribersFile)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					require.NoError(t, os.WriteFile(subscribersFile, []byte("email,name\n"+
+						"tf-test-set-file-1@example.com,One\n"+
+						"tf-test-set-file-2@example.com,\n"), 0o600))
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("listmonk_subscriber_set.test", "subscribers.#"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_set.test", "changes.subscribe", "2"),
+					resource.TestCheckResourceAttrSet("listmonk_subscriber_set.test", "members_sha256"),
+				),
+			},
+			// Update testing after the file changed
+			{
+				PreConfig: func() {
+					require.NoError(t, os.WriteFile(subscribersFile, []byte("email,name\n"+
+						"tf-test-set-file-1@example.com,One\n"+
+						"tf-test-set-file-3@example.com,\n"), 0o600))
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_subscriber_set.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_subscriber_set.test", "changes.subscribe", "1"),
+					resource.TestCheckResourceAttr("listmonk_subscriber_set.test", "changes.unsubscribe", "1"),
+				),
+			},
+			// Validation testing
+			{
+				Config: config + `
+				resource "listmonk_subscriber_set" "both" {
+					list_id          = listmonk_list.test.id
+					subscribers      = [{ email = "tf-test-set-file-1@example.com" }]
+					subscribers_file = "subscribers.csv"
+				}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of subscribers or subscribers_file must be set`),
+			},
+		},
+	})
+}
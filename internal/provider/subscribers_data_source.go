@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &SubscribersDataSource{}
+)
+
+// defaultSubscribersMaxResults is the default of max_results.
+const defaultSubscribersMaxResults = 1000
+
+func NewSubscribersDataSource() datasource.DataSource {
+	return &SubscribersDataSource{}
+}
+
+// SubscribersDataSource defines the data source implementation.
+type SubscribersDataSource struct {
+	client *listmonk.Client
+}
+
+// SubscribersDataSourceModel describes the data source data model.
+type SubscribersDataSourceModel struct {
+	Query              types.String                     `tfsdk:"query"`
+	ListIDs            []types.Int64                    `tfsdk:"list_ids"`
+	SubscriptionStatus types.String                     `tfsdk:"subscription_status"`
+	OrderBy            types.String                     `tfsdk:"order_by"`
+	Order              types.String                     `tfsdk:"order"`
+	MaxResults         types.Int64                      `tfsdk:"max_results"`
+	Total              types.Int64                      `tfsdk:"total"`
+	Subscribers        []SubscribersDataSourceItemModel `tfsdk:"subscribers"`
+}
+
+// SubscribersDataSourceItemModel describes a single subscriber returned by the data source.
+type SubscribersDataSourceItemModel struct {
+	ID        types.Int64   `tfsdk:"id"`
+	UUID      types.String  `tfsdk:"uuid"`
+	CreatedAt types.String  `tfsdk:"created_at"`
+	UpdatedAt types.String  `tfsdk:"updated_at"`
+	Email     types.String  `tfsdk:"email"`
+	Name      types.String  `tfsdk:"name"`
+	Status    types.String  `tfsdk:"status"`
+	Attribs   types.String  `tfsdk:"attribs"`
+	Lists     []types.Int64 `tfsdk:"lists"`
+}
+
+func (d *SubscribersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscribers"
+}
+
+func (d *SubscribersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Subscribers data source. Returns the subscribers matching a listmonk SQL expression and list filters.",
+
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "SQL expression to filter subscribers with. Example: `subscribers.attribs->>'city' = 'Berlin'`",
+				Optional:            true,
+			},
+			"list_ids": schema.ListAttribute{
+				MarkdownDescription: "Only return subscribers of these lists",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"subscription_status": schema.StringAttribute{
+				MarkdownDescription: "Only return subscribers whose subscription to `list_ids` has this status. One of `unconfirmed`, `confirmed` or `unsubscribed`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("unconfirmed", "confirmed", "unsubscribed"),
+					stringvalidator.AlsoRequires(path.MatchRoot("list_ids")),
+				},
+			},
+			"order_by": schema.StringAttribute{
+				MarkdownDescription: "Field to order subscribers by. One of `id`, `email`, `name`, `status`, `created_at` or `updated_at`. Defaults to `id`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("id", "email", "name", "status", "created_at", "updated_at"),
+				},
+			},
+			"order": schema.StringAttribute{
+				MarkdownDescription: "Sort order. One of `asc` or `desc`. Defaults to `asc`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("asc", "desc"),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Fail instead of reading more than this many subscribers. Defaults to `%d`", defaultSubscribersMaxResults),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Number of matching subscribers",
+				Computed:            true,
+			},
+			"subscribers": schema.ListNestedAttribute{
+				MarkdownDescription: "Subscribers matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Subscriber identifier",
+							Computed:            true,
+						},
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "Subscriber UUID",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Subscriber created at",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Subscriber updated at",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "E-mail address",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Subscriber name",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Subscriber status",
+							Computed:            true,
+						},
+						"attribs": schema.StringAttribute{
+							MarkdownDescription: "JSON object of subscriber attributes. It is a JSON string, as Terraform providers " +
+								"can't return dynamic values inside lists. Use `jsondecode` to access them",
+							Computed: true,
+						},
+						"lists": schema.ListAttribute{
+							MarkdownDescription: "IDs of the lists the subscriber is subscribed to",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SubscribersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *SubscribersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SubscribersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if !data.Query.IsNull() {
+		query.Set("query", data.Query.ValueString())
+	}
+	for _, listID := range data.ListIDs {
+		query.Add("list_id", strconv.FormatInt(listID.ValueInt64(), 10))
+	}
+	if !data.SubscriptionStatus.IsNull() {
+		query.Set("subscription_status", data.SubscriptionStatus.ValueString())
+	}
+	if !data.OrderBy.IsNull() {
+		query.Set("order_by", data.OrderBy.ValueString())
+	}
+	if !data.Order.IsNull() {
+		query.Set("order", data.Order.ValueString())
+	}
+	maxResults := int64(defaultSubscribersMaxResults)
+	if !data.MaxResults.IsNull() {
+		maxResults = data.MaxResults.ValueInt64()
+	}
+
+	// Count the matching subscribers with a single result before paging
+	// through them.
+	subscribers, total, err := d.client.GetSubscribersLimit(query, 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Subscribers, got error: %s", err))
+		return
+	}
+	if int64(total) > maxResults {
+		resp.Diagnostics.AddError(
+			"Too many subscribers",
+			fmt.Sprintf("The filters match %d subscribers, more than max_results (%d). Narrow down the filters or raise max_results.", total, maxResults),
+		)
+		return
+	}
+
+	// Get the subscribers from the client.
+	if total > len(subscribers) {
+		subscribers, total, err = d.client.GetSubscribersLimit(query, int(maxResults))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Subscribers, got error: %s", err))
+			return
+		}
+	}
+
+	// Set the data source state from the client response.
+	data.Total = types.Int64Value(int64(total))
+	data.Subscribers = []SubscribersDataSourceItemModel{}
+	for _, subscriber := range subscribers {
+		attribs := string(subscriber.Attribs)
+		if attribs == "" {
+			attribs = "{}"
+		}
+		lists := []types.Int64{}
+		for _, list := range subscriber.Lists {
+			lists = append(lists, types.Int64Value(int64(list.ID)))
+		}
+
+		data.Subscribers = append(data.Subscribers, SubscribersDataSourceItemModel{
+			ID:        types.Int64Value(int64(subscriber.ID)),
+			UUID:      types.StringValue(subscriber.UUID),
+			CreatedAt: types.StringValue(subscriber.CreatedAt),
+			UpdatedAt: types.StringValue(subscriber.UpdatedAt),
+			Email:     types.StringValue(subscriber.Email),
+			Name:      types.StringValue(subscriber.Name),
+			Status:    types.StringValue(subscriber.Status),
+			Attribs:   types.StringValue(attribs),
+			Lists:     lists,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
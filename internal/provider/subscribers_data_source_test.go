@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubscribersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "listmonk_subscribers" "example" {
+					query = "subscribers.attribs->>'city' = 'Bengaluru'"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_subscribers.example", "total", "1"),
+					resource.TestCheckResourceAttr("data.listmonk_subscribers.example", "subscribers.0.email", "john@example.com"),
+					resource.TestCheckResourceAttrSet("data.listmonk_subscribers.example", "subscribers.0.attribs"),
+				),
+			},
+			// List and order testing
+			{
+				Config: providerConfig + `
+				data "listmonk_subscribers" "example" {
+					list_ids = [1, 2]
+					order_by = "email"
+					order    = "desc"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_subscribers.example", "subscribers.0.email", "john@example.com"),
+				),
+			},
+			// max_results testing
+			{
+				Config: providerConfig + `
+				data "listmonk_subscribers" "example" {
+					max_results = 1
+				}
+`,
+				ExpectError: regexp.MustCompile(`Too many subscribers`),
+			},
+		},
+	})
+}
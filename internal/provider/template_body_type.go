@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = templateBodyType{}
+	_ basetypes.StringValuableWithSemanticEquals = templateBodyValue{}
+)
+
+// templateBodyType is a string type for template bodies. listmonk may change
+// line endings and trailing whitespace when it stores a template, so values
+// that only differ in those are considered semantically equal.
+type templateBodyType struct {
+	basetypes.StringType
+}
+
+func (t templateBodyType) Equal(o attr.Type) bool {
+	other, ok := o.(templateBodyType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t templateBodyType) String() string {
+	return "templateBodyType"
+}
+
+func (t templateBodyType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return templateBodyValue{StringValue: in}, nil
+}
+
+func (t templateBodyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t templateBodyType) ValueType(ctx context.Context) attr.Value {
+	return templateBodyValue{}
+}
+
+// templateBodyValue is the value of a templateBodyType attribute.
+type templateBodyValue struct {
+	basetypes.StringValue
+}
+
+func newTemplateBodyValue(value string) templateBodyValue {
+	return templateBodyValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v templateBodyValue) Equal(o attr.Value) bool {
+	other, ok := o.(templateBodyValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v templateBodyValue) Type(ctx context.Context) attr.Type {
+	return templateBodyType{}
+}
+
+// StringSemanticEquals returns true if both bodies are equal after normalising
+// line endings and trailing whitespace.
+func (v templateBodyValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(templateBodyValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normalizeTemplateBody(v.ValueString()) == normalizeTemplateBody(newValue.ValueString()), diags
+}
+
+// normalizeTemplateBody converts line endings to LF and strips trailing
+// whitespace from every line and from the end of the body.
+func normalizeTemplateBody(body string) string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	body = strings.ReplaceAll(body, "\r", "\n")
+
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// templateBodySHA256 returns the hex encoded SHA-256 of the normalised body.
+func templateBodySHA256(body string) string {
+	sum := sha256.Sum256([]byte(normalizeTemplateBody(body)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateBodySemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"equal": {
+			prior:    "<p>Hello</p>",
+			new:      "<p>Hello</p>",
+			expected: true,
+		},
+		"crlf": {
+			prior:    "<p>\r\nHello\r\n</p>\r\n",
+			new:      "<p>\nHello\n</p>",
+			expected: true,
+		},
+		"trailing whitespace": {
+			prior:    "<p>  \nHello\t\n</p>\n\n",
+			new:      "<p>\nHello\n</p>",
+			expected: true,
+		},
+		"leading whitespace": {
+			prior:    "  <p>Hello</p>",
+			new:      "<p>Hello</p>",
+			expected: false,
+		},
+		"changed content": {
+			prior:    "<p>Hello</p>",
+			new:      "<p>Hello there</p>",
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := newTemplateBodyValue(tc.prior).StringSemanticEquals(context.Background(), newTemplateBodyValue(tc.new))
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expected, equal)
+		})
+	}
+}
@@ -6,12 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"terraform-provider-listmonk/internal/listmonk"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,14 +29,15 @@ type TemplateDataSource struct {
 
 // TemplateDataSourceModel describes the data source data model.
 type TemplateDataSourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
-	Name      types.String `tfsdk:"name"`
-	Body      types.String `tfsdk:"body"`
-	Type      types.String `tfsdk:"type"`
-	IsDefault types.Bool   `tfsdk:"is_default"`
-	Subject   types.String `tfsdk:"subject"`
+	ID         types.Int64  `tfsdk:"id"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	Name       types.String `tfsdk:"name"`
+	Body       types.String `tfsdk:"body"`
+	Type       types.String `tfsdk:"type"`
+	IsDefault  types.Bool   `tfsdk:"is_default"`
+	Subject    types.String `tfsdk:"subject"`
+	BodySource types.String `tfsdk:"body_source"`
 }
 
 func (d *TemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -51,7 +50,7 @@ func (d *TemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		MarkdownDescription: "Template data source",
 
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
+			"id": schema.Int64Attribute{
 				MarkdownDescription: "Template identifier",
 				Required:            true,
 			},
@@ -83,6 +82,10 @@ func (d *TemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				MarkdownDescription: "Template subject",
 				Computed:            true,
 			},
+			"body_source": schema.StringAttribute{
+				MarkdownDescription: "JSON source of `campaign_visual` templates",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -93,18 +96,18 @@ func (d *TemplateDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*listmonk.Client)
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *listmonk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *TemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -117,24 +120,15 @@ func (d *TemplateDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Get the template id from the configuration.
-	var templateIdStr string
-	req.Config.GetAttribute(ctx, path.Root("id"), &templateIdStr)
-	templateId, err := strconv.Atoi(templateIdStr)
-	if err != nil {
-		resp.Diagnostics.AddError("Import error", fmt.Sprintf("Unable to to parse template id to int, got error: %s", err))
-		return
-	}
-
 	// Get the template from the client.
-	template, err := d.client.GetTemplate(templateId)
+	template, err := d.client.GetTemplate(int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Template, got error: %s", err))
 		return
 	}
 
 	// Set the data source state from the client response.
-	data.ID = types.StringValue(strconv.Itoa(template.ID))
+	data.ID = types.Int64Value(int64(template.ID))
 	data.CreatedAt = types.StringValue(template.CreatedAt)
 	data.UpdatedAt = types.StringValue(template.UpdatedAt)
 	data.Name = types.StringValue(template.Name)
@@ -142,6 +136,10 @@ func (d *TemplateDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.Type = types.StringValue(template.Type)
 	data.IsDefault = types.BoolValue(template.IsDefault)
 	data.Subject = types.StringValue(template.Subject)
+	data.BodySource = types.StringNull()
+	if template.BodySource != nil && *template.BodySource != "" {
+		data.BodySource = types.StringPointerValue(template.BodySource)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
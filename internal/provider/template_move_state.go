@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// templateStateMover returns a state mover that moves listmonk_template state
+// of the given template type to a type-specific template resource. The
+// template itself is kept in listmonk, so moving does not recreate it.
+func templateStateMover(ctx context.Context, templateType string, move func(ctx context.Context, source templateResourceModel, resp *resource.MoveStateResponse)) resource.StateMover {
+	var schemaResp resource.SchemaResponse
+	(&templateResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return resource.StateMover{
+		SourceSchema: &schemaResp.Schema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != "listmonk_template" || !strings.HasSuffix(strings.ToLower(req.SourceProviderAddress), "/listmonk") {
+				return
+			}
+
+			if req.SourceSchemaVersion != schemaResp.Schema.Version || req.SourceState == nil {
+				resp.Diagnostics.AddError(
+					"Unable to move template state",
+					fmt.Sprintf("listmonk_template state has schema version %d, expected %d. Refresh the state with this provider version before moving it.",
+						req.SourceSchemaVersion, schemaResp.Schema.Version),
+				)
+				return
+			}
+
+			var source templateResourceModel
+			resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if source.Type.ValueString() != templateType {
+				resp.Diagnostics.AddError(
+					"Unable to move template state",
+					fmt.Sprintf("Template %d has type %q and cannot be moved to a resource managing %q templates.",
+						source.ID.ValueInt64(), source.Type.ValueString(), templateType),
+				)
+				return
+			}
+
+			if source.Body.IsNull() {
+				resp.Diagnostics.AddError(
+					"Unable to move template state",
+					fmt.Sprintf("Template %d uses body_file, which %q resources do not support.", source.ID.ValueInt64(), templateType),
+				)
+				return
+			}
+
+			move(ctx, source, resp)
+		},
+	}
+}
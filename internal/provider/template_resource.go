@@ -3,13 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 	"terraform-provider-listmonk/internal/listmonk"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,11 +22,18 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &templateResource{}
-	_ resource.ResourceWithConfigure   = &templateResource{}
-	_ resource.ResourceWithImportState = &templateResource{}
+	_ resource.Resource                   = &templateResource{}
+	_ resource.ResourceWithConfigure      = &templateResource{}
+	_ resource.ResourceWithImportState    = &templateResource{}
+	_ resource.ResourceWithUpgradeState   = &templateResource{}
+	_ resource.ResourceWithModifyPlan     = &templateResource{}
+	_ resource.ResourceWithValidateConfig = &templateResource{}
 )
 
+// visualTemplateType is the type of templates built with the visual editor.
+// They carry their editor source in body_source and need listmonk v5.0 or later.
+const visualTemplateType = "campaign_visual"
+
 // NewtemplateResource is a helper function to simplify the provider implementation.
 func NewTemplateResource() resource.Resource {
 	return &templateResource{}
@@ -34,35 +45,44 @@ func (r *templateResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*listmonk.Client)
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *listmonk.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.providerData = providerData
 }
 
 // templateResource is the resource implementation.
 type templateResource struct {
-	client *listmonk.Client
+	client       *listmonk.Client
+	providerData *ListmonkProviderData
 }
 
 // templateResourceModel describes the resource data model.
 type templateResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
-	Name      types.String `tfsdk:"name"`
-	Body      types.String `tfsdk:"body"`
-	Type      types.String `tfsdk:"type"`
-	IsDefault types.Bool   `tfsdk:"is_default"`
-	Subject   types.String `tfsdk:"subject"`
+	ID         types.Int64       `tfsdk:"id"`
+	CreatedAt  types.String      `tfsdk:"created_at"`
+	UpdatedAt  types.String      `tfsdk:"updated_at"`
+	Name       types.String      `tfsdk:"name"`
+	Body       templateBodyValue `tfsdk:"body"`
+	BodyFile   types.String      `tfsdk:"body_file"`
+	BodySHA256 types.String      `tfsdk:"body_sha256"`
+	Type       types.String      `tfsdk:"type"`
+	IsDefault  types.Bool        `tfsdk:"is_default"`
+	Subject    types.String      `tfsdk:"subject"`
+	BodySource jsonStringValue   `tfsdk:"body_source"`
+	// ReassignToTemplateID is only used on deletion.
+	ReassignToTemplateID types.Int64 `tfsdk:"reassign_to_template_id"`
+	// AdoptExisting is only used on creation.
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
 }
 
 // Metadata returns the resource type name.
@@ -75,13 +95,16 @@ func (t *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Template data source",
+		// Version must be bumped, with a state upgrader added in UpgradeState,
+		// whenever the schema changes in a way existing state cannot be read with.
+		Version: 1,
 
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
+			"id": schema.Int64Attribute{
 				MarkdownDescription: "Template identifier",
 				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -100,11 +123,23 @@ func (t *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required:            true,
 			},
 			"body": schema.StringAttribute{
-				MarkdownDescription: "Template body",
-				Required:            true,
+				MarkdownDescription: "Template body. Differences in line endings and trailing whitespace are ignored. " +
+					"Exactly one of `body` or `body_file` must be set",
+				Optional:   true,
+				CustomType: templateBodyType{},
+			},
+			"body_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the template body. The body is sent to listmonk on create and update, " +
+					"but only its SHA-256 is stored in the state, which keeps state and plans small for very large templates. " +
+					"Changes to the file and to the template in listmonk are detected by comparing hashes",
+				Optional: true,
+			},
+			"body_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 of the template body, after normalising line endings and trailing whitespace",
+				Computed:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Template type",
+				MarkdownDescription: "Template type: `campaign`, `campaign_visual` or `tx`",
 				Required:            true,
 			},
 			"is_default": schema.BoolAttribute{
@@ -118,6 +153,21 @@ func (t *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				MarkdownDescription: "Template subject",
 				Required:            true,
 			},
+			"body_source": schema.StringAttribute{
+				MarkdownDescription: "JSON source of a `campaign_visual` template as exported from the visual editor. " +
+					"Formatting and key order differences are ignored. Requires listmonk v5.0 or later",
+				Optional:   true,
+				CustomType: jsonStringType{},
+			},
+			"reassign_to_template_id": schema.Int64Attribute{
+				MarkdownDescription: "Template to move references to when this template is deleted. " +
+					"If this template is the default template or is used by campaigns, deletion fails unless this is set, " +
+					"in which case the other template is made the default and the campaigns are switched to it before deletion. " +
+					"Only draft, scheduled and paused campaigns can be switched; deletion fails without changes if other campaigns use the template. " +
+					"Must be applied before the template is destroyed",
+				Optional: true,
+			},
+			"adopt_existing": adoptExistingAttribute("template"),
 		},
 	}
 }
@@ -132,27 +182,37 @@ func (t *templateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	body, diags := plan.plannedTemplateBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
 	template := listmonk.Template{
-		Body:    plan.Body.ValueString(),
+		Body:    body,
 		Name:    plan.Name.ValueString(),
 		Subject: plan.Subject.ValueString(),
 		Type:    plan.Type.ValueString(),
 	}
+	if !plan.BodySource.IsNull() {
+		resp.Diagnostics.Append(t.checkBodySourceSupported()...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		template.BodySource = plan.BodySource.ValueStringPointer()
+	}
 
-	// Create the resource
-	r, err := t.client.CreateTemplate(&template)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create template",
-			fmt.Sprintf("Failed to create template: %s", err),
-		)
-
+	// Create the resource, or update an adopted one
+	r, diags := createOrAdoptTemplate(ctx, t.client, shouldAdoptExisting(plan.AdoptExisting, t.providerData), &template)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.ID = types.StringValue(strconv.Itoa(r.ID))
+	plan.ID = types.Int64Value(int64(r.ID))
+	plan.BodySHA256 = types.StringValue(templateBodySHA256(body))
 	plan.CreatedAt = types.StringValue(r.CreatedAt)
 	plan.UpdatedAt = types.StringValue(r.UpdatedAt)
 	plan.IsDefault = types.BoolValue(r.IsDefault)
@@ -178,14 +238,7 @@ func (t *templateResource) Read(ctx context.Context, req resource.ReadRequest, r
 	tflog.Info(ctx, "Reading template")
 
 	// Get refreshed template value from Listmonk
-	templateId, err := strconv.Atoi(state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to parse template ID (reading)",
-			fmt.Sprintf("Unable to parse template ID: %s", err),
-		)
-	}
-	template, err := t.client.GetTemplate(templateId)
+	template, err := t.client.GetTemplate(int(state.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to read template",
@@ -199,10 +252,19 @@ func (t *templateResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.CreatedAt = types.StringValue(template.CreatedAt)
 	state.UpdatedAt = types.StringValue(template.UpdatedAt)
 	state.Name = types.StringValue(template.Name)
-	state.Body = types.StringValue(template.Body)
+	// Only the hash of the body is kept when it is read from a file
+	if state.BodyFile.IsNull() {
+		state.Body = newTemplateBodyValue(template.Body)
+	}
+	state.BodySHA256 = types.StringValue(templateBodySHA256(template.Body))
 	state.Type = types.StringValue(template.Type)
 	state.IsDefault = types.BoolValue(template.IsDefault)
 	state.Subject = types.StringValue(template.Subject)
+	if template.BodySource != nil && *template.BodySource != "" {
+		state.BodySource = newJSONStringValue(*template.BodySource)
+	} else {
+		state.BodySource = newJSONStringNull()
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
@@ -221,22 +283,28 @@ func (t *templateResource) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	templateId, err := strconv.ParseInt(plan.ID.ValueString(), 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to parse template ID (updating)",
-			fmt.Sprintf("Unable to parse template ID: %s", err),
-		)
+
+	body, diags := plan.plannedTemplateBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+
 	// Generate API request body from plan
 	template := listmonk.Template{
-		ID:      int(templateId),
-		Body:    plan.Body.ValueString(),
+		ID:      int(plan.ID.ValueInt64()),
+		Body:    body,
 		Name:    plan.Name.ValueString(),
 		Subject: plan.Subject.ValueString(),
 		Type:    plan.Type.ValueString(),
 	}
+	if !plan.BodySource.IsNull() {
+		resp.Diagnostics.Append(t.checkBodySourceSupported()...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		template.BodySource = plan.BodySource.ValueStringPointer()
+	}
 
 	// Update existing template
 	r, err := t.client.UpdateTemplate(&template)
@@ -251,6 +319,7 @@ func (t *templateResource) Update(ctx context.Context, req resource.UpdateReques
 	// Map response body to schema and populate Computed attribute values
 	// plan.ID = types.Int64Value(int64(r.ID))
 	// plan.CreatedAt = types.StringValue(r.CreatedAt)
+	plan.BodySHA256 = types.StringValue(templateBodySHA256(body))
 	plan.UpdatedAt = types.StringValue(r.UpdatedAt)
 	plan.IsDefault = types.BoolValue(r.IsDefault)
 
@@ -271,27 +340,329 @@ func (t *templateResource) Delete(ctx context.Context, req resource.DeleteReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	templateId, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+
+	// Delete existing template
+	resp.Diagnostics.Append(deleteTemplate(t.client, int(state.ID.ValueInt64()), state.ReassignToTemplateID)...)
+}
+
+func (r *templateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	templateId, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to parse template ID (deleting)",
+			"Unable to parse template ID (importing)",
 			fmt.Sprintf("Unable to parse template ID: %s", err),
 		)
 		return
 	}
-	// Delete existing template
-	err = t.client.DeleteTemplate(int(templateId))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), templateId)...)
+}
+
+// ValidateConfig checks that the body is set once and that body_source is only
+// used with visual templates.
+func (t *templateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config templateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Body.IsUnknown() && !config.BodyFile.IsUnknown() && config.Body.IsNull() == config.BodyFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("body"),
+			"Invalid body configuration",
+			"Exactly one of body or body_file must be set.",
+		)
+	}
+
+	if config.Type.IsUnknown() || config.BodySource.IsUnknown() {
+		return
+	}
+
+	if config.Type.ValueString() == visualTemplateType && config.BodySource.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("body_source"),
+			"Missing body_source",
+			fmt.Sprintf("Templates of type %q require body_source.", visualTemplateType),
+		)
+	}
+	if config.Type.ValueString() != visualTemplateType && !config.BodySource.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("body_source"),
+			"Unexpected body_source",
+			fmt.Sprintf("body_source can only be set on templates of type %q.", visualTemplateType),
+		)
+	}
+}
+
+// ModifyPlan plans the hash of the body, reading it from body_file if set.
+func (t *templateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan templateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Body.IsUnknown() || plan.BodyFile.IsUnknown() {
+		plan.BodySHA256 = types.StringUnknown()
+	} else {
+		body, diags := plan.templateBody()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.BodySHA256 = types.StringValue(templateBodySHA256(body))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("body_sha256"), plan.BodySHA256)...)
+}
+
+// templateBody returns the configured body, reading it from body_file if set.
+func (m templateResourceModel) templateBody() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.BodyFile.IsNull() {
+		return m.Body.ValueString(), diags
+	}
+
+	content, err := os.ReadFile(m.BodyFile.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddAttributeError(
+			path.Root("body_file"),
+			"Unable to read body_file",
+			fmt.Sprintf("Unable to read body_file: %s", err),
+		)
+		return "", diags
+	}
+
+	return string(content), diags
+}
+
+// plannedTemplateBody returns the body to apply, making sure it still
+// matches the planned hash.
+func (m templateResourceModel) plannedTemplateBody() (string, diag.Diagnostics) {
+	body, diags := m.templateBody()
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !m.BodySHA256.IsUnknown() && m.BodySHA256.ValueString() != templateBodySHA256(body) {
+		diags.AddAttributeError(
+			path.Root("body_file"),
+			"body_file changed",
+			"The content of body_file changed after the plan was created. Run terraform plan again.",
+		)
+	}
+
+	return body, diags
+}
+
+// checkBodySourceSupported returns an error if the server is older than listmonk v5.0,
+// which introduced visual templates.
+func (t *templateResource) checkBodySourceSupported() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	config, err := t.client.GetServerConfig()
+	if err != nil {
+		diags.AddError(
+			"Failed to read server config",
+			fmt.Sprintf("Failed to read server config: %s", err),
+		)
+		return diags
+	}
+	if !config.VersionAtLeast(5, 0) {
+		diags.AddAttributeError(
+			path.Root("body_source"),
+			"Visual templates not supported",
+			fmt.Sprintf("body_source requires listmonk v5.0 or later, the server runs %s.", config.Version),
+		)
+	}
+
+	return diags
+}
+
+// deleteTemplate deletes a template after checking that it is neither the
+// default template nor used by any campaign. If reassignTo is set, those
+// references are moved to that template first, otherwise deletion fails
+// with a diagnostic listing them. A template that is already gone is
+// considered deleted.
+func deleteTemplate(client *listmonk.Client, templateID int, reassignTo types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	template, err := client.GetTemplate(templateID)
+	if listmonk.IsNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Deleting Template",
+			"Could not read template, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	campaigns, err := client.GetCampaigns()
+	if err != nil {
+		diags.AddError(
+			"Error Deleting Template",
+			"Could not read campaigns, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Check every reference before changing anything
+	reassign, d := templateReassignments(template, campaigns, reassignTo)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if template.IsDefault {
+		targetID := int(reassignTo.ValueInt64())
+		err = client.SetDefaultTemplate(targetID)
+		if err != nil {
+			diags.AddError(
+				"Error Deleting Template",
+				fmt.Sprintf("Could not make template %d the default template, unexpected error: %s", targetID, err),
+			)
+			return diags
+		}
+	}
+	for _, campaign := range reassign {
+		targetID := int(reassignTo.ValueInt64())
+		err = client.ReplaceCampaignTemplate(campaign.ID, templateID, targetID)
+		if err != nil {
+			diags.AddError(
+				"Error Deleting Template",
+				fmt.Sprintf("Could not switch campaign %q (ID %d) to template %d, unexpected error: %s", campaign.Name, campaign.ID, targetID, err),
+			)
+			return diags
+		}
+	}
+
+	err = client.DeleteTemplate(templateID)
+	if err != nil && !listmonk.IsNotFound(err) {
+		diags.AddError(
 			"Error Deleting Template",
 			"Could not delete template, unexpected error: "+err.Error(),
 		)
-		return
 	}
+
+	return diags
 }
 
-func (r *templateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	id := path.Root("id")
-	resource.ImportStatePassthroughID(ctx, id, req, resp)
+// templateReassignments returns the campaigns using a template that must be
+// switched to reassignTo before the template is deleted. It fails if the
+// template is in use and reassignTo is null, or if a campaign using it can't
+// be edited.
+func templateReassignments(template *listmonk.Template, campaigns []listmonk.Campaign, reassignTo types.Int64) ([]listmonk.Campaign, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var using, locked []listmonk.Campaign
+	for _, campaign := range campaigns {
+		if campaign.TemplateID != template.ID && campaign.ArchiveTemplateID != template.ID {
+			continue
+		}
+		using = append(using, campaign)
+		if !campaign.Editable() {
+			locked = append(locked, campaign)
+		}
+	}
+	if !template.IsDefault && len(using) == 0 {
+		return nil, diags
+	}
+
+	if reassignTo.IsNull() {
+		var reasons []string
+		if template.IsDefault {
+			reasons = append(reasons, "- it is the default template")
+		}
+		for _, campaign := range using {
+			reasons = append(reasons, fmt.Sprintf("- it is used by campaign %q (ID %d, status %s)", campaign.Name, campaign.ID, campaign.Status))
+		}
+		detail := fmt.Sprintf("Template %d cannot be deleted:\n%s\n\n", template.ID, strings.Join(reasons, "\n")) +
+			"Set reassign_to_template_id and apply it before destroying the template to move these references to another template."
+		if len(locked) > 0 {
+			detail += " Running, finished and cancelled campaigns can't be switched and must be deleted first."
+		}
+		diags.AddError("Template Is In Use", detail)
+		return nil, diags
+	}
+
+	if int(reassignTo.ValueInt64()) == template.ID {
+		diags.AddAttributeError(
+			path.Root("reassign_to_template_id"),
+			"Error Deleting Template",
+			"reassign_to_template_id must refer to another template.",
+		)
+		return nil, diags
+	}
+
+	if len(locked) > 0 {
+		var reasons []string
+		for _, campaign := range locked {
+			reasons = append(reasons, fmt.Sprintf("- campaign %q (ID %d, status %s)", campaign.Name, campaign.ID, campaign.Status))
+		}
+		diags.AddError(
+			"Template Is In Use",
+			fmt.Sprintf("Template %d cannot be deleted: it is used by campaigns that can't be edited, so they can't be switched to template %d:\n%s\n\n",
+				template.ID, reassignTo.ValueInt64(), strings.Join(reasons, "\n"))+
+				"Only draft, scheduled and paused campaigns can be switched. Delete these campaigns before destroying the template.",
+		)
+		return nil, diags
+	}
+
+	return using, diags
+}
+
+// createOrAdoptTemplate creates a template. If adopt is true and a template
+// with the same name exists, that template is updated instead.
+func createOrAdoptTemplate(ctx context.Context, client *listmonk.Client, adopt bool, template *listmonk.Template) (*listmonk.Template, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Look up an existing template with the same name to adopt
+	if adopt {
+		templates, err := client.GetTemplates()
+		if err != nil {
+			diags.AddError(
+				"Failed to create template",
+				fmt.Sprintf("Failed to look up existing templates: %s", err),
+			)
+			return nil, diags
+		}
+		existing, err := findByName(*templates, template.Name, func(t listmonk.Template) string { return t.Name })
+		if err != nil {
+			diags.AddError(
+				"Failed to adopt existing template",
+				fmt.Sprintf("Failed to adopt existing template: %s", err),
+			)
+			return nil, diags
+		}
+		if existing != nil {
+			tflog.Info(ctx, "Adopting existing template", map[string]interface{}{"id": existing.ID})
+			template.ID = existing.ID
+			r, err := client.UpdateTemplate(template)
+			if err != nil {
+				diags.AddError(
+					"Failed to adopt existing template",
+					fmt.Sprintf("Failed to update existing template: %s", err),
+				)
+			}
+			return r, diags
+		}
+	}
+
+	r, err := client.CreateTemplate(template)
+	if err != nil {
+		diags.AddError(
+			"Failed to create template",
+			fmt.Sprintf("Failed to create template: %s", err),
+		)
+	}
+
+	return r, diags
 }
@@ -1,9 +1,21 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccTemplateResource(t *testing.T) {
@@ -57,3 +69,197 @@ func TestAccTemplateResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccTemplateResourceVisual(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_template" "visual" {
+					body        = "<p>Hello world</p>"
+					name        = "tf-test-visual"
+					subject     = ""
+					type        = "campaign_visual"
+					body_source = jsonencode({ root = { type = "EmailLayout", data = { childrenIds = [] } } })
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_template.visual", "type", "campaign_visual"),
+					resource.TestCheckResourceAttrSet("listmonk_template.visual", "body_source"),
+				),
+			},
+		},
+	})
+}
+
+func TestTemplateResourceUpgradeStateV0(t *testing.T) {
+	server, err := testAccProtoV6ProviderFactories["listmonk"]()
+	require.NoError(t, err)
+
+	resp, err := server.UpgradeResourceState(context.Background(), &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "listmonk_template",
+		Version:  0,
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{
+				"id": "3",
+				"created_at": "2024-01-01T00:00:00Z",
+				"updated_at": "2024-01-01T00:00:00Z",
+				"name": "tf-test",
+				"body": "<p>Hello world</p>",
+				"type": "tx",
+				"is_default": false,
+				"subject": "test1"
+			}`),
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	upgraded, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas["listmonk_template"].ValueType())
+	require.NoError(t, err)
+
+	var attributes map[string]tftypes.Value
+	require.NoError(t, upgraded.As(&attributes))
+	var id *big.Float
+	require.NoError(t, attributes["id"].As(&id))
+	value, _ := id.Int64()
+	assert.Equal(t, int64(3), value)
+	var body string
+	require.NoError(t, attributes["body"].As(&body))
+	assert.Equal(t, "<p>Hello world</p>", body)
+	var sha string
+	require.NoError(t, attributes["body_sha256"].As(&sha))
+	assert.Equal(t, templateBodySHA256("<p>Hello world</p>"), sha)
+	for _, name := range []string{"body_file", "body_source", "reassign_to_template_id", "adopt_existing"} {
+		assert.True(t, attributes[name].IsNull(), name)
+	}
+}
+
+func TestTemplateReassignments(t *testing.T) {
+	template := &listmonk.Template{ID: 3, IsDefault: true}
+	campaigns := []listmonk.Campaign{
+		{ID: 1, Name: "draft", Status: "draft", TemplateID: 3, ArchiveTemplateID: 1},
+		{ID: 2, Name: "paused", Status: "paused", TemplateID: 1, ArchiveTemplateID: 3},
+		{ID: 3, Name: "other", Status: "finished", TemplateID: 1, ArchiveTemplateID: 1},
+	}
+
+	t.Run("unused", func(t *testing.T) {
+		reassign, diags := templateReassignments(&listmonk.Template{ID: 4}, campaigns, types.Int64Null())
+		assert.False(t, diags.HasError())
+		assert.Empty(t, reassign)
+	})
+
+	t.Run("blocking", func(t *testing.T) {
+		_, diags := templateReassignments(template, campaigns, types.Int64Null())
+		require.True(t, diags.HasError())
+		detail := diags.Errors()[0].Detail()
+		assert.Contains(t, detail, "it is the default template")
+		assert.Contains(t, detail, `campaign "draft" (ID 1, status draft)`)
+		assert.Contains(t, detail, `campaign "paused" (ID 2, status paused)`)
+		assert.NotContains(t, detail, `"other"`)
+	})
+
+	t.Run("reassign", func(t *testing.T) {
+		reassign, diags := templateReassignments(template, campaigns, types.Int64Value(1))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, campaigns[:2], reassign)
+	})
+
+	t.Run("same template", func(t *testing.T) {
+		_, diags := templateReassignments(template, campaigns, types.Int64Value(3))
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("not editable", func(t *testing.T) {
+		locked := append(campaigns, listmonk.Campaign{ID: 4, Name: "sent", Status: "finished", TemplateID: 3})
+		reassign, diags := templateReassignments(template, locked, types.Int64Value(1))
+		require.True(t, diags.HasError())
+		assert.Empty(t, reassign)
+		detail := diags.Errors()[0].Detail()
+		assert.Contains(t, detail, `campaign "sent" (ID 4, status finished)`)
+		assert.NotContains(t, detail, `"draft"`)
+	})
+}
+
+func TestAccTemplateResourceAdoptExisting(t *testing.T) {
+	var existingID int
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+					existing, err := client.CreateTemplate(&listmonk.Template{
+						Name:    "tf-test-adopt",
+						Body:    "<p>Created by hand</p>",
+						Subject: "hand",
+						Type:    "tx",
+					})
+					require.NoError(t, err)
+					existingID = existing.ID
+				},
+				Config: providerConfig + `
+				resource "listmonk_template" "adopt" {
+					body           = "<p>Managed by terraform</p>"
+					name           = "tf-test-adopt"
+					subject        = "terraform"
+					type           = "tx"
+					adopt_existing = true
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("listmonk_template.adopt", "id", func(value string) error {
+						if value != strconv.Itoa(existingID) {
+							return fmt.Errorf("expected existing template %d to be adopted, got %s", existingID, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("listmonk_template.adopt", "body", "<p>Managed by terraform</p>"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTemplateResourceBodyFile(t *testing.T) {
+	bodyFile := filepath.Join(t.TempDir(), "body.html")
+	config := providerConfig + fmt.Sprintf(`
+				resource "listmonk_template" "file" {
+					body_file = %q
+					name      = "tf-test-file"
+					subject   = "test1"
+					type      = "tx"
+				}
+`, bodyFile)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					require.NoError(t, os.WriteFile(bodyFile, []byte("<p>Hello world</p>\r\n"), 0o600))
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("listmonk_template.file", "body"),
+					resource.TestCheckResourceAttr("listmonk_template.file", "body_sha256", templateBodySHA256("<p>Hello world</p>")),
+				),
+			},
+			// Update testing after the file changed
+			{
+				PreConfig: func() {
+					require.NoError(t, os.WriteFile(bodyFile, []byte("<p>Hello there</p>"), 0o600))
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_template.file", "body_sha256", templateBodySHA256("<p>Hello there</p>")),
+				),
+			},
+		},
+	})
+}
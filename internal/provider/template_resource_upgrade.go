@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// templateResourceModelV0 describes the resource data model of schema version 0,
+// which stored the identifier as a string.
+type templateResourceModelV0 struct {
+	ID        types.String `tfsdk:"id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	Name      types.String `tfsdk:"name"`
+	Body      types.String `tfsdk:"body"`
+	Type      types.String `tfsdk:"type"`
+	IsDefault types.Bool   `tfsdk:"is_default"`
+	Subject   types.String `tfsdk:"subject"`
+}
+
+// templateResourceSchemaV0 is a frozen copy of schema version 0. Only the
+// attribute types matter to state upgrades, so descriptions and plan
+// modifiers are left out. It must not change when attributes are added.
+func templateResourceSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{Computed: true},
+			"created_at": schema.StringAttribute{Computed: true},
+			"updated_at": schema.StringAttribute{Computed: true},
+			"name":       schema.StringAttribute{Required: true},
+			"body":       schema.StringAttribute{Required: true},
+			"type":       schema.StringAttribute{Required: true},
+			"is_default": schema.BoolAttribute{Computed: true},
+			"subject":    schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+// UpgradeState migrates state from older schema versions. Each upgrader
+// converts its version straight to the current one. Attributes added since
+// a version are optional, so they are upgraded as null; only changes that
+// existing state can't be read with need a new version.
+func (t *templateResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: templateResourceSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior templateResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				templateId, err := strconv.ParseInt(prior.ID.ValueString(), 10, 64)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to parse template ID (upgrading state)",
+						fmt.Sprintf("Unable to parse template ID: %s", err),
+					)
+					return
+				}
+
+				body := prior.Body.ValueString()
+				upgraded := templateResourceModel{
+					ID:                   types.Int64Value(templateId),
+					CreatedAt:            prior.CreatedAt,
+					UpdatedAt:            prior.UpdatedAt,
+					Name:                 prior.Name,
+					Body:                 newTemplateBodyValue(body),
+					BodyFile:             types.StringNull(),
+					BodySHA256:           types.StringValue(templateBodySHA256(body)),
+					Type:                 prior.Type,
+					IsDefault:            prior.IsDefault,
+					Subject:              prior.Subject,
+					BodySource:           jsonStringValue{StringValue: types.StringNull()},
+					ReassignToTemplateID: types.Int64Null(),
+					AdoptExisting:        types.BoolNull(),
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSourceWithConfigure = &TemplatesDataSource{}
+)
+
+func NewTemplatesDataSource() datasource.DataSource {
+	return &TemplatesDataSource{}
+}
+
+// TemplatesDataSource defines the data source implementation.
+type TemplatesDataSource struct {
+	client *listmonk.Client
+}
+
+// TemplatesDataSourceModel describes the data source data model.
+type TemplatesDataSourceModel struct {
+	Type        types.String                   `tfsdk:"type"`
+	NameRegex   types.String                   `tfsdk:"name_regex"`
+	IncludeBody types.Bool                     `tfsdk:"include_body"`
+	Templates   []TemplatesDataSourceItemModel `tfsdk:"templates"`
+}
+
+// TemplatesDataSourceItemModel describes a single template returned by the data source.
+type TemplatesDataSourceItemModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	Name       types.String `tfsdk:"name"`
+	Body       types.String `tfsdk:"body"`
+	Type       types.String `tfsdk:"type"`
+	IsDefault  types.Bool   `tfsdk:"is_default"`
+	Subject    types.String `tfsdk:"subject"`
+	BodySource types.String `tfsdk:"body_source"`
+}
+
+func (d *TemplatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_templates"
+}
+
+func (d *TemplatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Templates data source. Returns all templates, optionally filtered by type and name.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return templates of this type. Example: `campaign`, `tx`",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return templates whose name matches this regular expression",
+				Optional:            true,
+			},
+			"include_body": schema.BoolAttribute{
+				MarkdownDescription: "Include the template body in the results. Defaults to `false`",
+				Optional:            true,
+			},
+			"templates": schema.ListNestedAttribute{
+				MarkdownDescription: "Templates matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Template identifier",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Template created at",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Template updated at",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Template name",
+							Computed:            true,
+						},
+						"body": schema.StringAttribute{
+							MarkdownDescription: "Template body. Only set when `include_body` is `true`",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Template type",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Template is default",
+							Computed:            true,
+						},
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "Template subject",
+							Computed:            true,
+						},
+						"body_source": schema.StringAttribute{
+							MarkdownDescription: "JSON source of `campaign_visual` templates. Only set when `include_body` is `true`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *TemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TemplatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid name_regex",
+				fmt.Sprintf("Unable to compile regular expression: %s", err),
+			)
+			return
+		}
+	}
+
+	// Get the templates from the client.
+	templates, err := d.client.GetTemplates()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Templates, got error: %s", err))
+		return
+	}
+
+	// Set the data source state from the client response.
+	data.Templates = []TemplatesDataSourceItemModel{}
+	for _, template := range *templates {
+		if !data.Type.IsNull() && template.Type != data.Type.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(template.Name) {
+			continue
+		}
+
+		item := TemplatesDataSourceItemModel{
+			ID:         types.Int64Value(int64(template.ID)),
+			CreatedAt:  types.StringValue(template.CreatedAt),
+			UpdatedAt:  types.StringValue(template.UpdatedAt),
+			Name:       types.StringValue(template.Name),
+			Body:       types.StringNull(),
+			Type:       types.StringValue(template.Type),
+			IsDefault:  types.BoolValue(template.IsDefault),
+			Subject:    types.StringValue(template.Subject),
+			BodySource: types.StringNull(),
+		}
+		if data.IncludeBody.ValueBool() {
+			item.Body = types.StringValue(template.Body)
+			if template.BodySource != nil && *template.BodySource != "" {
+				item.BodySource = types.StringPointerValue(template.BodySource)
+			}
+		}
+		data.Templates = append(data.Templates, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
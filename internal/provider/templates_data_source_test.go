@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTemplatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "listmonk_templates" "example" {
+					type       = "campaign"
+					name_regex = "^Default"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_templates.example", "templates.0.id", "1"),
+					resource.TestCheckResourceAttr("data.listmonk_templates.example", "templates.0.name", "Default campaign template"),
+					resource.TestCheckNoResourceAttr("data.listmonk_templates.example", "templates.0.body"),
+				),
+			},
+			// Body testing
+			{
+				Config: providerConfig + `
+				data "listmonk_templates" "example" {
+					name_regex   = "^Default campaign template$"
+					include_body = true
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.listmonk_templates.example", "templates.#", "1"),
+					resource.TestCheckResourceAttrSet("data.listmonk_templates.example", "templates.0.body"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-listmonk/internal/listmonk"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &txTemplateResource{}
+	_ resource.ResourceWithConfigure   = &txTemplateResource{}
+	_ resource.ResourceWithImportState = &txTemplateResource{}
+	_ resource.ResourceWithMoveState   = &txTemplateResource{}
+)
+
+// txTemplateType is the listmonk type of transactional templates.
+const txTemplateType = "tx"
+
+// NewTxTemplateResource is a helper function to simplify the provider implementation.
+func NewTxTemplateResource() resource.Resource {
+	return &txTemplateResource{}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *txTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ListmonkProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ListmonkProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// txTemplateResource is the resource implementation.
+type txTemplateResource struct {
+	client       *listmonk.Client
+	providerData *ListmonkProviderData
+}
+
+// txTemplateResourceModel describes the resource data model.
+type txTemplateResourceModel struct {
+	ID        types.Int64       `tfsdk:"id"`
+	CreatedAt types.String      `tfsdk:"created_at"`
+	UpdatedAt types.String      `tfsdk:"updated_at"`
+	Name      types.String      `tfsdk:"name"`
+	Body      templateBodyValue `tfsdk:"body"`
+	Subject   types.String      `tfsdk:"subject"`
+	// AdoptExisting is only used on creation.
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
+// Metadata returns the resource type name.
+func (t *txTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tx_template"
+}
+
+// Schema defines the schema for the resource.
+func (t *txTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Transactional template. Existing `listmonk_template` resources of type `tx` can be moved to this resource with a `moved` block (Terraform 1.8+)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Template identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Template created at",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Template updated at",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Template name",
+				Required:            true,
+			},
+			"body": schema.StringAttribute{
+				MarkdownDescription: "Template body. Differences in line endings and trailing whitespace are ignored",
+				Required:            true,
+				CustomType:          templateBodyType{},
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the messages sent with this template. Can contain template expressions",
+				Required:            true,
+			},
+			"adopt_existing": adoptExistingAttribute("template"),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (t *txTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan txTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	template := listmonk.Template{
+		Body:    plan.Body.ValueString(),
+		Name:    plan.Name.ValueString(),
+		Subject: plan.Subject.ValueString(),
+		Type:    txTemplateType,
+	}
+
+	// Create the resource, or update an adopted one
+	r, diags := createOrAdoptTemplate(ctx, t.client, shouldAdoptExisting(plan.AdoptExisting, t.providerData), &template)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(int64(r.ID))
+	plan.CreatedAt = types.StringValue(r.CreatedAt)
+	plan.UpdatedAt = types.StringValue(r.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (t *txTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state txTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "id", state.ID)
+	tflog.Info(ctx, "Reading transactional template")
+
+	// Get refreshed template value from Listmonk
+	template, err := t.client.GetTemplate(int(state.ID.ValueInt64()))
+	if listmonk.IsNotFound(err) {
+		tflog.Warn(ctx, "Transactional template not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read template",
+			fmt.Sprintf("Failed to read template: %s", err),
+		)
+
+		return
+	}
+	if template.Type != txTemplateType {
+		resp.Diagnostics.AddError(
+			"Unexpected template type",
+			fmt.Sprintf("Template %d has type %q, expected %q.", template.ID, template.Type, txTemplateType),
+		)
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.CreatedAt = types.StringValue(template.CreatedAt)
+	state.UpdatedAt = types.StringValue(template.UpdatedAt)
+	state.Name = types.StringValue(template.Name)
+	state.Body = newTemplateBodyValue(template.Body)
+	state.Subject = types.StringValue(template.Subject)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (t *txTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan txTemplateResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	template := listmonk.Template{
+		ID:      int(plan.ID.ValueInt64()),
+		Body:    plan.Body.ValueString(),
+		Name:    plan.Name.ValueString(),
+		Subject: plan.Subject.ValueString(),
+		Type:    txTemplateType,
+	}
+
+	// Update existing template
+	r, err := t.client.UpdateTemplate(&template)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update template",
+			fmt.Sprintf("Failed to update template: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.UpdatedAt = types.StringValue(r.UpdatedAt)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (t *txTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state txTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing template. Transactional templates cannot be the
+	// default template or be used by campaigns, so there is nothing to reassign.
+	err := t.client.DeleteTemplate(int(state.ID.ValueInt64()))
+	if err != nil && !listmonk.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Template",
+			"Could not delete template, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+func (r *txTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	templateId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse template ID (importing)",
+			fmt.Sprintf("Unable to parse template ID: %s", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), templateId)...)
+}
+
+// MoveState moves listmonk_template resources of type tx to this resource.
+func (t *txTemplateResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		templateStateMover(ctx, txTemplateType, func(ctx context.Context, source templateResourceModel, resp *resource.MoveStateResponse) {
+			target := txTemplateResourceModel{
+				ID:            source.ID,
+				CreatedAt:     source.CreatedAt,
+				UpdatedAt:     source.UpdatedAt,
+				Name:          source.Name,
+				Body:          source.Body,
+				Subject:       source.Subject,
+				AdoptExisting: source.AdoptExisting,
+			}
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, target)...)
+		}),
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-listmonk/internal/listmonk"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccTxTemplateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_tx_template" "test" {
+					body = "<p>Hello world</p>"
+					name = "tf-test-tx"
+				}
+`,
+				ExpectError: regexp.MustCompile(`The argument "subject" is required`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_tx_template" "test" {
+					body    = "<p>Hello {{ .Subscriber.FirstName }}</p>"
+					name    = "tf-test-tx"
+					subject = "Hello"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_tx_template.test", "name", "tf-test-tx"),
+					resource.TestCheckResourceAttr("listmonk_tx_template.test", "subject", "Hello"),
+					resource.TestCheckResourceAttrSet("listmonk_tx_template.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "listmonk_tx_template.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_at"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "listmonk_tx_template" "test" {
+					body    = "<p>Hello {{ .Subscriber.FirstName }}</p>"
+					name    = "tf-test-tx"
+					subject = "Hello again"
+				}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_tx_template.test", "subject", "Hello again"),
+				),
+			},
+			// Read removes templates deleted outside of terraform
+			{
+				PreConfig: func() {
+					client := listmonk.NewClient(fmt.Sprintf("http://localhost:%s", dockerClient.ContainerPort), "listmonk", "listmonk", nil)
+					templates, err := client.GetTemplates()
+					require.NoError(t, err)
+					for _, template := range *templates {
+						if template.Name == "tf-test-tx" {
+							require.NoError(t, client.DeleteTemplate(template.ID))
+						}
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if _, ok := s.RootModule().Resources["listmonk_tx_template.test"]; ok {
+						return fmt.Errorf("expected deleted template to be removed from state")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccTxTemplateResourceMoveState(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "listmonk_template" "test" {
+					body    = "<p>Hello</p>"
+					name    = "tf-test-tx-move"
+					subject = "Hello"
+					type    = "tx"
+				}
+`,
+			},
+			{
+				Config: providerConfig + `
+				moved {
+					from = listmonk_template.test
+					to   = listmonk_tx_template.test
+				}
+
+				resource "listmonk_tx_template" "test" {
+					body    = "<p>Hello</p>"
+					name    = "tf-test-tx-move"
+					subject = "Hello"
+				}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("listmonk_tx_template.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("listmonk_tx_template.test", "name", "tf-test-tx-move"),
+				),
+			},
+		},
+	})
+}